@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"cloud.google.com/go/storage"
+)
+
+type objectGrantStruct struct {
+	entity storage.ACLEntity
+	role   storage.ACLRole
+}
+
+func parseObjectGrants(grants []string) []objectGrantStruct {
+
+	var objectGrants []objectGrantStruct
+	for _, grant := range grants {
+		separatorIndex := strings.LastIndex(grant, ":")
+		if separatorIndex <= 0 {
+			fatal(ErrCategoryUnknown, "Grant parameter must be in entity:role form! (Grant: "+grant+")")
+		}
+
+		role := storage.ACLRole(strings.ToUpper(grant[separatorIndex+1:]))
+		if role != storage.RoleReader && role != storage.RoleOwner {
+			fatal(ErrCategoryUnknown, "Grant role must be either READER or OWNER for objects! (Grant: "+grant+")")
+		}
+
+		objectGrants = append(objectGrants, objectGrantStruct{entity: storage.ACLEntity(grant[:separatorIndex]), role: role})
+	}
+
+	return objectGrants
+
+}
+
+func checkObjectACLAllowed(ctx context.Context, bkt *storage.BucketHandle) error {
+
+	bktAttrs, err := bucketAttrs(ctx, preflightBucket(bkt))
+	if err != nil {
+		LogWarn.Println("WARNING: Cannot fetch bucket info to check uniform bucket-level access! (" + errorDetail(err) + ")")
+		return nil
+	}
+
+	if bktAttrs.UniformBucketLevelAccess.Enabled {
+		return newCategorizedError(ErrCategoryUnknown, "Bucket has uniform bucket-level access enabled, object ACLs cannot be used, manage access with IAM instead!")
+	}
+
+	return nil
+
+}
+
+func applyObjectGrants(ctx context.Context, obj *storage.ObjectHandle, objectGrants []objectGrantStruct) error {
+
+	for _, objectGrant := range objectGrants {
+		err := obj.ACL().Set(ctx, objectGrant.entity, objectGrant.role)
+		if err != nil {
+			return newCategorizedError(classifyError(err), "Cannot grant access on object! (Entity: "+string(objectGrant.entity)+", "+errorDetail(err)+")")
+		}
+		LogInfo.Println("INFO: Access granted on object. (Entity: " + string(objectGrant.entity) + ", Role: " + string(objectGrant.role) + ")")
+	}
+
+	return nil
+
+}
+
+func removePublicAccess(ctx context.Context, obj *storage.ObjectHandle) error {
+
+	for _, entity := range []storage.ACLEntity{storage.AllUsers, storage.AllAuthenticatedUsers} {
+		err := obj.ACL().Delete(ctx, entity)
+		if err != nil {
+			if classifyError(err) == ErrCategoryNotFound {
+				LogDebug.Println("DEBUG: Object has no access for entity, nothing to remove. (Entity: " + string(entity) + ")")
+				continue
+			}
+			return newCategorizedError(classifyError(err), "Cannot remove public access on object! (Entity: "+string(entity)+", "+errorDetail(err)+")")
+		}
+		LogInfo.Println("INFO: Public access removed on object. (Entity: " + string(entity) + ")")
+	}
+
+	return nil
+
+}
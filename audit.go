@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	AuditActorUnknown = "unknown"
+	auditActorTimeout = 10 * time.Second
+	auditTailChunk    = 4096
+)
+
+func auditActor() string {
+
+	ctx, cancel := context.WithTimeout(context.Background(), auditActorTimeout)
+	defer cancel()
+
+	identity, _, err := credentialsIdentity(ctx)
+	if err != nil {
+		return AuditActorUnknown
+	}
+
+	return identity
+
+}
+
+func lastAuditLine(file *os.File) ([]byte, error) {
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	var tail []byte
+	for offset := info.Size(); offset > 0; {
+		chunkSize := min(offset, auditTailChunk)
+		offset -= chunkSize
+
+		chunk := make([]byte, chunkSize)
+		_, err = file.ReadAt(chunk, offset)
+		if err != nil {
+			return nil, err
+		}
+		tail = append(chunk, tail...)
+
+		content := bytes.TrimRight(tail, "\n")
+		index := bytes.LastIndexByte(content, '\n')
+		if index >= 0 {
+			return content[index+1:], nil
+		}
+	}
+
+	return bytes.TrimRight(tail, "\n"), nil
+
+}
+
+func appendAuditLine(auditPath string, actor string, result string) error {
+
+	file, err := os.OpenFile(auditPath, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	err = lockFile(file)
+	if err != nil {
+		return err
+	}
+	defer unlockFile(file)
+
+	lastLine, err := lastAuditLine(file)
+	if err != nil {
+		return err
+	}
+	chain := sha256.Sum256(lastLine)
+
+	line := time.Now().UTC().Format(time.RFC3339) +
+		" actor=" + strconv.Quote(actor) +
+		" action=" + strconv.Quote(appFlag.ActionType) +
+		" bucket=" + strconv.Quote(appFlag.BucketName) +
+		" object=" + strconv.Quote(appFlag.ObjectPath) +
+		" result=" + result +
+		" bytes=" + strconv.FormatInt(runMetrics.bytesTransferred.Load(), 10) +
+		" chain=" + hex.EncodeToString(chain[:]) + "\n"
+
+	_, err = file.WriteString(line)
+	if err != nil {
+		return err
+	}
+
+	return file.Sync()
+
+}
+
+func writeAuditLine(auditPath string, result string) {
+
+	err := appendAuditLine(auditPath, auditActor(), result)
+	if err != nil {
+		LogWarn.Println("WARNING: Cannot write audit log! (" + err.Error() + ")")
+	}
+
+}
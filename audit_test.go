@@ -0,0 +1,48 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLastAuditLine(t *testing.T) {
+
+	longLine := strings.Repeat("x", auditTailChunk+10)
+
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{"", ""},
+		{"first\n", "first"},
+		{"first\nsecond\n", "second"},
+		{"first\nsecond", "second"},
+		{"first\n" + longLine + "\n", longLine},
+		{longLine + "\nlast\n", "last"},
+		{strings.Repeat("a", auditTailChunk-1) + "\nlast\n", "last"},
+	}
+
+	for _, test := range tests {
+		auditPath := filepath.Join(t.TempDir(), "audit.log")
+		err := os.WriteFile(auditPath, []byte(test.content), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		file, err := os.Open(auditPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := lastAuditLine(file)
+		file.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != test.want {
+			t.Errorf("lastAuditLine() = %.20q, want %.20q", got, test.want)
+		}
+	}
+
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strconv"
+
+	"cloud.google.com/go/storage"
+)
+
+const defaultBase64MaxSize = 1024 * 1024
+
+func downloadBase64(storageUnderlyingDataObject *storageUnderlyingDataStruct, bucketName string, objectPath string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	maxSize := int64(defaultBase64MaxSize)
+	if appFlag.MaxSize > 0 {
+		maxSize = int64(appFlag.MaxSize)
+	}
+
+	obj := client.Bucket(bucketName).Object(objectPath)
+	if appFlag.Generation > 0 {
+		obj = obj.Generation(appFlag.Generation)
+	}
+
+	obj = obj.ReadCompressed(appFlag.RawDownload)
+
+	reader, err := openObjectReader(ctx, obj, 0, -1)
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			fatal(classifyError(err), "Object does not exist! ("+errorDetail(err)+")")
+		}
+		fatal(classifyError(err), "Cannot create new reader! ("+errorDetail(err)+")")
+	}
+	defer reader.Close()
+
+	if reader.Attrs.Size > maxSize {
+		fatal(ErrCategoryUnknown, "Object size exceeds max-size limit, base64 output aborted! (Object's SIZE: "+strconv.FormatInt(reader.Attrs.Size, 10)+", Max Size: "+strconv.FormatInt(maxSize, 10)+")")
+	}
+
+	content, err := io.ReadAll(io.LimitReader(reader, maxSize+1))
+	if err != nil {
+		fatal(classifyError(err), "Cannot read object from bucket! ("+errorDetail(err)+")")
+	}
+	if int64(len(content)) > maxSize {
+		fatal(ErrCategoryUnknown, "Object content exceeds max-size limit, base64 output aborted! (Max Size: "+strconv.FormatInt(maxSize, 10)+")")
+	}
+
+	fmt.Println(base64.StdEncoding.EncodeToString(content))
+
+	runMetrics.objectsDownloaded.Add(1)
+	runMetrics.bytesTransferred.Add(int64(len(content)))
+	recordObject(objectPath, RecordDownloaded, int64(len(content)), formatCRC32C(crc32.Checksum(content, crc32cTable)), 0, nil)
+
+	LogInfo.Println("SUCCESS: Object printed as base64. (Read Bytes: " + strconv.Itoa(len(content)) + ")")
+
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+var predefinedBucketACLs = map[string]string{
+	"private":            "private",
+	"project-private":    "projectPrivate",
+	"public-read":        "publicRead",
+	"public-read-write":  "publicReadWrite",
+	"authenticated-read": "authenticatedRead",
+}
+
+var corsMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodPost:    true,
+	http.MethodPut:     true,
+	http.MethodPatch:   true,
+	http.MethodDelete:  true,
+	http.MethodOptions: true,
+}
+
+func splitList(value string) []string {
+
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+
+}
+
+func bucketCORS() []storage.CORS {
+
+	origins := splitList(appFlag.CORSOrigins)
+	if len(origins) == 0 {
+		if appFlag.CORSMethods != "" || appFlag.CORSMaxAge != 0 {
+			fatal(ErrCategoryUnknown, "Cors-origin parameter must be filled when other CORS parameters are set!")
+		}
+		return nil
+	}
+
+	methods := []string{http.MethodGet}
+	if appFlag.CORSMethods != "" {
+		methods = nil
+		for _, method := range splitList(appFlag.CORSMethods) {
+			method = strings.ToUpper(method)
+			if !corsMethods[method] {
+				fatal(ErrCategoryUnknown, "Wrong cors-method parameter specified! (Method: "+method+")")
+			}
+			methods = append(methods, method)
+		}
+	}
+
+	return []storage.CORS{{
+		Origins: origins,
+		Methods: methods,
+		MaxAge:  appFlag.CORSMaxAge,
+	}}
+
+}
+
+func createBucket(storageUnderlyingDataObject *storageUnderlyingDataStruct, bucketName string, projectID string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	bktAttrs := &storage.BucketAttrs{
+		Location: appFlag.Location,
+		UniformBucketLevelAccess: storage.UniformBucketLevelAccess{
+			Enabled: appFlag.UniformAccess,
+		},
+	}
+
+	bktAttrs.CORS = bucketCORS()
+
+	if appFlag.BucketACL != "" {
+		predefinedACL, ok := predefinedBucketACLs[strings.ToLower(appFlag.BucketACL)]
+		if !ok {
+			fatal(ErrCategoryUnknown, "Wrong bucket-acl parameter specified!")
+		}
+		bktAttrs.PredefinedACL = predefinedACL
+	}
+
+	if appFlag.Retention > 0 {
+		bktAttrs.RetentionPolicy = &storage.RetentionPolicy{RetentionPeriod: appFlag.Retention}
+		LogWarn.Println("WARNING: Retention policy prevents deleting or overwriting objects until they expire, and locking it later is irreversible!")
+	}
+
+	if !appFlag.UniformAccess {
+		LogWarn.Println("WARNING: Uniform bucket-level access is disabled, object ACLs will be effective on the bucket!")
+	}
+
+	bkt := client.Bucket(bucketName)
+
+	err := bkt.Create(ctx, projectID, bktAttrs)
+	if err != nil {
+		fatal(classifyError(err), "Cannot create bucket on GCP! ("+errorDetail(err)+")")
+	}
+
+	if appFlag.ExtraChecks || appFlag.Retention > 0 {
+		bktAttrsNew, err := bucketAttrs(ctx, bkt)
+		if err != nil {
+			fatal(classifyError(err), "Cannot fetch bucket info! ("+errorDetail(err)+")")
+		}
+
+		if bktAttrsNew.RetentionPolicy != nil {
+			LogInfo.Println("INFO: Retention policy set on bucket. (Retention Period: " + bktAttrsNew.RetentionPolicy.RetentionPeriod.String() + ", Effective Time: " + bktAttrsNew.RetentionPolicy.EffectiveTime.UTC().Format(time.RFC3339) + ")")
+		}
+
+		LogInfo.Println("SUCCESS: Bucket created on GCP. (Created Bucket's LOCATION: " + bktAttrsNew.Location + ", CLASS: " + bktAttrsNew.StorageClass + ", UNIFORM ACCESS: " + strconv.FormatBool(bktAttrsNew.UniformBucketLevelAccess.Enabled) + ")")
+	} else {
+		LogInfo.Println("SUCCESS: Bucket created on GCP. (Uniform Access: " + strconv.FormatBool(appFlag.UniformAccess) + ")")
+	}
+
+}
+
+func checkBucketPlacement(bktAttrs *storage.BucketAttrs) error {
+
+	if appFlag.RequireLocation != "" && !strings.EqualFold(bktAttrs.Location, appFlag.RequireLocation) {
+		return newCategorizedError(ErrCategoryUnknown, "Bucket location does not match required location! (Bucket's LOCATION: "+bktAttrs.Location+", Required LOCATION: "+appFlag.RequireLocation+")")
+	}
+	if appFlag.RequireClass != "" && !strings.EqualFold(bktAttrs.StorageClass, appFlag.RequireClass) {
+		return newCategorizedError(ErrCategoryUnknown, "Bucket storage class does not match required class! (Bucket's CLASS: "+bktAttrs.StorageClass+", Required CLASS: "+appFlag.RequireClass+")")
+	}
+
+	LogDebug.Println("DEBUG: Bucket placement checked. (Bucket's LOCATION: " + bktAttrs.Location + ", CLASS: " + bktAttrs.StorageClass + ")")
+
+	return nil
+
+}
+
+func checkBucketAutoclass(bktAttrs *storage.BucketAttrs) error {
+
+	if bktAttrs.Autoclass == nil || !bktAttrs.Autoclass.Enabled {
+		return nil
+	}
+
+	if appFlag.StorageClass != "" {
+		return newCategorizedError(ErrCategoryUnknown, "Storage-class parameter cannot be used when bucket has Autoclass enabled, class is managed automatically! (Requested CLASS: "+appFlag.StorageClass+")")
+	}
+
+	LogDebug.Println("DEBUG: Bucket has Autoclass enabled, storage class is managed automatically.")
+
+	return nil
+
+}
+
+func checkBucketPrivate(bktAttrs *storage.BucketAttrs) error {
+
+	publicAccessPrevention := bktAttrs.PublicAccessPrevention.String()
+	uniformAccess := strconv.FormatBool(bktAttrs.UniformBucketLevelAccess.Enabled)
+
+	LogInfo.Println("INFO: Bucket access posture checked. (Bucket's PUBLIC ACCESS PREVENTION: " + publicAccessPrevention + ", UNIFORM ACCESS: " + uniformAccess + ")")
+
+	if bktAttrs.PublicAccessPrevention != storage.PublicAccessPreventionEnforced {
+		return newCategorizedError(ErrCategoryUnknown, "Bucket does not enforce public access prevention, upload aborted! (Bucket's PUBLIC ACCESS PREVENTION: "+publicAccessPrevention+")")
+	}
+	if !bktAttrs.UniformBucketLevelAccess.Enabled {
+		return newCategorizedError(ErrCategoryUnknown, "Bucket does not have uniform access enabled, object ACLs may grant access, upload aborted!")
+	}
+
+	return nil
+
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+func callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+
+	if appFlag.CallTimeout == 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, time.Duration(appFlag.CallTimeout)*time.Second)
+
+}
+
+func objectAttrs(ctx context.Context, obj *storage.ObjectHandle) (*storage.ObjectAttrs, error) {
+
+	callCtx, callCancel := callContext(ctx)
+	defer callCancel()
+
+	return obj.Attrs(callCtx)
+
+}
+
+func bucketAttrs(ctx context.Context, bkt *storage.BucketHandle) (*storage.BucketAttrs, error) {
+
+	callCtx, callCancel := callContext(ctx)
+	defer callCancel()
+
+	return bkt.Attrs(callCtx)
+
+}
+
+func openObjectReader(ctx context.Context, obj *storage.ObjectHandle, offset int64, length int64) (*storage.Reader, error) {
+
+	if appFlag.CallTimeout == 0 {
+		return obj.NewRangeReader(ctx, offset, length)
+	}
+
+	openCtx, openCancel := context.WithCancelCause(ctx)
+	timer := time.AfterFunc(time.Duration(appFlag.CallTimeout)*time.Second, func() {
+		openCancel(context.DeadlineExceeded)
+	})
+
+	reader, err := obj.NewRangeReader(openCtx, offset, length)
+	if !timer.Stop() {
+		if err == nil {
+			reader.Close()
+		}
+		return nil, context.Cause(openCtx)
+	}
+	if err != nil {
+		openCancel(nil)
+		return nil, err
+	}
+
+	return reader, nil
+
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	CRCFormatDecimal = "decimal"
+	CRCFormatHex     = "hex"
+	CRCFormatBase64  = "base64"
+)
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+func fileCRC32C(file *os.File) (uint32, error) {
+
+	_, err := file.Seek(0, io.SeekStart)
+	if err != nil {
+		return 0, err
+	}
+
+	hash := crc32.New(crc32cTable)
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return 0, err
+	}
+
+	return hash.Sum32(), nil
+
+}
+
+func fileSHA256(filePath string) (string, error) {
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+
+}
+
+func formatCRC32C(crc uint32) string {
+
+	switch strings.ToLower(appFlag.CRCFormat) {
+	case CRCFormatHex:
+		return fmt.Sprintf("%08x", crc)
+	case CRCFormatBase64:
+		return base64.StdEncoding.EncodeToString(binary.BigEndian.AppendUint32(nil, crc))
+	}
+
+	return strconv.FormatUint(uint64(crc), 10)
+
+}
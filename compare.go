@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+type compareResultStruct struct {
+	OnlyLocal  []string `json:"only_local"`
+	OnlyRemote []string `json:"only_remote"`
+	Differing  []string `json:"differing"`
+}
+
+func compareDirectory(storageUnderlyingDataObject *storageUnderlyingDataStruct, dirPath string, bucketName string, prefix string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	objectPrefix := ""
+	if prefix != "" {
+		objectPrefix = strings.TrimRight(prefix, "/") + "/"
+	}
+
+	localPaths := make(map[string]string)
+	err := filepath.WalkDir(dirPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		relPath, err := filepath.Rel(dirPath, filePath)
+		if err != nil {
+			return err
+		}
+		localPaths[filepath.ToSlash(relPath)] = filePath
+		return nil
+	})
+	if err != nil {
+		fatal(classifyError(err), "Cannot walk requested directory! ("+errorDetail(err)+")")
+	}
+
+	query := &storage.Query{Prefix: objectPrefix}
+	err = query.SetAttrSelection([]string{"Name", "CRC32C"})
+	if err != nil {
+		fatal(classifyError(err), "Cannot create object query! ("+errorDetail(err)+")")
+	}
+
+	result := compareResultStruct{OnlyLocal: []string{}, OnlyRemote: []string{}, Differing: []string{}}
+	remotePaths := make(map[string]bool)
+
+	it := client.Bucket(bucketName).Objects(ctx, query)
+	for {
+		objAttrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			fatal(classifyError(err), "Cannot list objects! ("+errorDetail(err)+")")
+		}
+
+		relPath := strings.TrimPrefix(objAttrs.Name, objectPrefix)
+		if relPath == "" || strings.HasSuffix(relPath, "/") {
+			continue
+		}
+		remotePaths[relPath] = true
+
+		filePath, ok := localPaths[relPath]
+		if !ok {
+			result.OnlyRemote = append(result.OnlyRemote, relPath)
+			continue
+		}
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			fatal(classifyError(err), "Cannot open requested file! (File: "+filePath+", "+errorDetail(err)+")")
+		}
+		crc, err := indexedFileCRC32C(file)
+		file.Close()
+		if err != nil {
+			fatal(classifyError(err), "Cannot compute checksum of requested file! (File: "+filePath+", "+errorDetail(err)+")")
+		}
+		if crc != objAttrs.CRC32C {
+			result.Differing = append(result.Differing, relPath)
+		}
+	}
+
+	for relPath := range localPaths {
+		if !remotePaths[relPath] {
+			result.OnlyLocal = append(result.OnlyLocal, relPath)
+		}
+	}
+
+	sort.Strings(result.OnlyLocal)
+	sort.Strings(result.OnlyRemote)
+	sort.Strings(result.Differing)
+
+	if appFlag.JSONOutput || strings.EqualFold(appFlag.OutputFormat, OutputJSON) {
+		printJSON(result)
+	} else {
+		for _, relPath := range result.OnlyLocal {
+			fmt.Println("ONLY-LOCAL\t" + relPath)
+		}
+		for _, relPath := range result.OnlyRemote {
+			fmt.Println("ONLY-REMOTE\t" + relPath)
+		}
+		for _, relPath := range result.Differing {
+			fmt.Println("DIFFERING\t" + relPath)
+		}
+	}
+
+	LogInfo.Println("SUCCESS: Local directory compared with GCP Bucket. (Only Local: " + strconv.Itoa(len(result.OnlyLocal)) + ", Only Remote: " + strconv.Itoa(len(result.OnlyRemote)) + ", Differing: " + strconv.Itoa(len(result.Differing)) + ")")
+
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+const (
+	maxComposeParts       = 32
+	compositeCleanupLimit = 60 * time.Second
+)
+
+func uploadFileComposite(storageUnderlyingDataObject *storageUnderlyingDataStruct, filePath string, bucketName string, objectPath string, contentType string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+	defer trackRecordObject(objectPath)()
+
+	_, err := uploadObjectComposite(ctx, client, filePath, bucketName, objectPath, contentType)
+	if err != nil {
+		fatal(classifyError(err), err.Error())
+	}
+
+}
+
+func uploadObjectComposite(ctx context.Context, client *storage.Client, filePath string, bucketName string, objectPath string, contentType string) (uploadResultStruct, error) {
+
+	uploadStart := time.Now()
+	result := uploadResultStruct{status: RecordUploaded, objectPath: objectPath}
+
+	if filePath == StdioPath {
+		return result, newCategorizedError(ErrCategoryUnknown, "Split-parts parameter cannot be used when uploading from stdin!")
+	}
+
+	partCount := int64(appFlag.SplitParts)
+	if partCount > maxComposeParts {
+		return result, newCategorizedError(ErrCategoryUnknown, "Split-parts parameter cannot be more than "+strconv.Itoa(maxComposeParts)+"!")
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return result, newCategorizedError(classifyError(err), "Cannot open requested file! ("+errorDetail(err)+")")
+	}
+	defer file.Close()
+
+	if len(appFlag.AllowTypes) > 0 {
+		err = checkAllowedType(file, filePath)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return result, newCategorizedError(classifyError(err), "Cannot stat requested file! ("+errorDetail(err)+")")
+	}
+	if info.Size() < partCount {
+		return result, newCategorizedError(ErrCategoryUnknown, "File is too small to split into requested parts!")
+	}
+
+	partSize := (info.Size() + partCount - 1) / partCount
+	partCount = (info.Size() + partSize - 1) / partSize
+
+	bkt := client.Bucket(bucketName)
+
+	partPaths := make([]string, partCount)
+	partIndexes := make(map[string]int64, partCount)
+	for i := int64(0); i < partCount; i++ {
+		partPaths[i] = objectPath + PartSuffix + fmt.Sprintf("%03d", i+1)
+		partIndexes[partPaths[i]] = i
+	}
+
+	partGenerations := make([]int64, partCount)
+
+	cleanupParts := func() {
+		cleanupCtx, cleanupCancel := context.WithTimeout(context.Background(), compositeCleanupLimit)
+		defer cleanupCancel()
+		for i, partPath := range partPaths {
+			if partGenerations[i] == 0 {
+				continue
+			}
+			err := bkt.Object(partPath).If(storage.Conditions{GenerationMatch: partGenerations[i]}).Delete(cleanupCtx)
+			if err != nil && err != storage.ErrObjectNotExist {
+				LogWarn.Println("WARNING: Cannot delete part object! (Part: " + partPath + ", GENERATION: " + strconv.FormatInt(partGenerations[i], 10) + ", " + errorDetail(err) + ")")
+			}
+		}
+	}
+
+	var failedCount atomic.Int64
+	var timedOut timedOutList
+	transferStart := time.Now()
+
+	var table summaryTable
+
+	runWorkerPool(int(appFlag.Concurrency), partPaths, func(partPath string) {
+		section := io.NewSectionReader(file, partIndexes[partPath]*partSize, partSize)
+
+		partCtx, partCancel := objectContext(ctx)
+		defer partCancel()
+
+		partStart := time.Now()
+		writer := bkt.Object(partPath).If(storage.Conditions{DoesNotExist: true}).NewWriter(partCtx)
+		bytes, err := copyBuffered(writer, transferReader(partCtx, section))
+		closeErr := writer.Close()
+		if err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			if objectTimedOut(partCtx, err) && ctx.Err() == nil {
+				timedOut.add(partPath)
+			}
+			if isPreconditionFailed(err) {
+				LogErr.Println("ERROR: Part object already exists, part not overwritten! (Part: " + partPath + ")")
+			} else {
+				LogErr.Println("ERROR: Cannot upload part to bucket! (Part: " + partPath + ", " + errorDetail(err) + ")")
+			}
+			failedCount.Add(1)
+			countError(classifyError(err))
+			table.add(partPath, "FAILED", bytes, time.Since(partStart))
+			return
+		}
+		partGenerations[partIndexes[partPath]] = writer.Attrs().Generation
+		table.add(partPath, "UPLOADED", bytes, time.Since(partStart))
+		LogDebug.Println("DEBUG: Part uploaded to GCP Bucket. (Part: " + partPath + ")")
+	})
+
+	table.print()
+
+	if failedCount.Load() > 0 {
+		cleanupParts()
+		summary := "Failed Parts: " + strconv.FormatInt(failedCount.Load(), 10)
+		if len(timedOut.items) > 0 {
+			summary += ", Timed Out Parts: " + timedOut.String()
+		}
+		return result, newCategorizedError(ErrCategoryUnknown, "Cannot upload some parts to bucket! ("+summary+")")
+	}
+
+	sources := make([]*storage.ObjectHandle, partCount)
+	for i, partPath := range partPaths {
+		sources[i] = bkt.Object(partPath).Generation(partGenerations[i])
+	}
+
+	composer := bkt.Object(objectPath).ComposerFrom(sources...)
+	composer.ContentType = contentType
+	composer.Metadata = objectMetadata()
+	composer.CacheControl = uploadSpec.CacheControl
+	composer.ContentLanguage = appFlag.ContentLanguage
+	composer.ContentEncoding = uploadSpec.ContentEncoding
+	if appFlag.StorageClass != "" {
+		composer.StorageClass = strings.ToUpper(appFlag.StorageClass)
+	}
+
+	objAttrs, err := composer.Run(ctx)
+	cleanupParts()
+	if err != nil {
+		return result, newCategorizedError(classifyError(err), "Cannot compose parts in bucket! ("+errorDetail(err)+")")
+	}
+	transferElapsed := time.Since(transferStart)
+	result.bytes = objAttrs.Size
+
+	crc, err := fileCRC32C(file)
+	if err != nil {
+		return result, newCategorizedError(classifyError(err), "Cannot compute checksum of requested file! ("+errorDetail(err)+")")
+	}
+	if crc != objAttrs.CRC32C {
+		cleanupCtx, cleanupCancel := context.WithTimeout(context.Background(), compositeCleanupLimit)
+		defer cleanupCancel()
+		err = bkt.Object(objectPath).If(storage.Conditions{GenerationMatch: objAttrs.Generation}).Delete(cleanupCtx)
+		if err != nil && err != storage.ErrObjectNotExist {
+			LogWarn.Println("WARNING: Cannot delete composed object! (Object: " + objectPath + ", GENERATION: " + strconv.FormatInt(objAttrs.Generation, 10) + ", " + errorDetail(err) + ")")
+		}
+		return result, newCategorizedError(ErrCategoryUnknown, "Checksum mismatch after composing parts, composed object discarded! (Object's CRC32: "+formatCRC32C(objAttrs.CRC32C)+", Local File's CRC32: "+formatCRC32C(crc)+")")
+	}
+
+	if appFlag.WebhookURL != "" {
+		notifyWebhook(appFlag.WebhookURL, objAttrs, time.Since(uploadStart))
+	}
+
+	recordManifestEntry(objAttrs)
+
+	runMetrics.objectsUploaded.Add(1)
+	runMetrics.bytesTransferred.Add(objAttrs.Size)
+	recordObject(objAttrs.Name, RecordUploaded, objAttrs.Size, formatCRC32C(objAttrs.CRC32C), time.Since(uploadStart), nil)
+
+	if appFlag.Throughput {
+		reportThroughput(objAttrs.Size, transferElapsed)
+	}
+
+	logSuccess("Object uploaded to GCP Bucket via composite upload.", "Uploaded Object's SIZE: "+strconv.FormatInt(objAttrs.Size, 10)+", CRC32: "+formatCRC32C(objAttrs.CRC32C)+", GENERATION: "+strconv.FormatInt(objAttrs.Generation, 10)+", PARTS: "+strconv.FormatInt(partCount, 10), objAttrs)
+
+	return result, nil
+
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/storage"
+)
+
+const (
+	ConflictOverwrite = "overwrite"
+	ConflictSkip      = "skip"
+	ConflictRename    = "rename"
+)
+
+const maxConflictSuffix = 1000
+
+func conflictObjectName(objectPath string, suffix int) string {
+
+	extension := path.Ext(path.Base(objectPath))
+
+	return strings.TrimSuffix(objectPath, extension) + "-" + strconv.Itoa(suffix) + extension
+
+}
+
+func objectExistsOnConflict(ctx context.Context, obj *storage.ObjectHandle) (bool, error) {
+
+	_, err := cachedObjectAttrs(ctx, obj)
+	if err == storage.ErrObjectNotExist {
+		return false, nil
+	}
+	if err != nil {
+		return false, newCategorizedError(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
+	}
+
+	return true, nil
+
+}
+
+func skipOnConflict(obj *storage.ObjectHandle) {
+
+	LogInfo.Println("SKIPPED: Object exists, upload skipped on conflict. (Object: " + obj.ObjectName() + ")")
+	recordObject(obj.ObjectName(), RecordSkipped, 0, "", 0, nil)
+
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestConflictObjectName(t *testing.T) {
+
+	tests := []struct {
+		objectPath string
+		suffix     int
+		want       string
+	}{
+		{"a/b.txt", 1, "a/b-1.txt"},
+		{"b.txt", 12, "b-12.txt"},
+		{"archive.tar.gz", 2, "archive.tar-2.gz"},
+		{"noext", 3, "noext-3"},
+		{"dir.d/noext", 1, "dir.d/noext-1"},
+	}
+
+	for _, test := range tests {
+		if got := conflictObjectName(test.objectPath, test.suffix); got != test.want {
+			t.Errorf("conflictObjectName(%q, %d) = %q, want %q", test.objectPath, test.suffix, got, test.want)
+		}
+	}
+
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"cloud.google.com/go/storage"
+	"golang.org/x/oauth2/google"
+)
+
+const adcFileName = "application_default_credentials.json"
+
+const IdentityAnonymous = "anonymous"
+
+const tokenInfoURL = "https://oauth2.googleapis.com/tokeninfo"
+
+type keyFileStruct struct {
+	Type        string `json:"type"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+}
+
+func readKeyFile(keyPath string) (*keyFileStruct, error) {
+
+	content, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	keyFile := new(keyFileStruct)
+	err = json.Unmarshal(content, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyFile, nil
+
+}
+
+func adcFilePath() (string, error) {
+
+	configDir := os.Getenv("CLOUDSDK_CONFIG")
+	if configDir == "" {
+		if runtime.GOOS == "windows" {
+			appData := os.Getenv("APPDATA")
+			if appData == "" {
+				return "", errors.New("APPDATA environment variable is not set")
+			}
+			configDir = filepath.Join(appData, "gcloud")
+		} else {
+			homeDir, err := os.UserHomeDir()
+			if err != nil {
+				return "", err
+			}
+			configDir = filepath.Join(homeDir, ".config", "gcloud")
+		}
+	}
+
+	adcPath := filepath.Join(configDir, adcFileName)
+
+	_, err := os.Stat(adcPath)
+	if err != nil {
+		return "", err
+	}
+
+	return adcPath, nil
+
+}
+
+type tokenInfoStruct struct {
+	Email   string `json:"email"`
+	Subject string `json:"sub"`
+}
+
+var cachedIdentity struct {
+	once            sync.Once
+	identity        string
+	credentialsType string
+	err             error
+}
+
+func tokenIdentity(ctx context.Context, accessToken string) (string, error) {
+
+	form := url.Values{"access_token": {accessToken}}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenInfoURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	transport, err := baseTransport()
+	if err != nil {
+		return "", err
+	}
+
+	response, err := (&http.Client{Transport: transport}).Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", errors.New("token info request failed with status " + response.Status)
+	}
+
+	tokenInfo := new(tokenInfoStruct)
+	err = json.NewDecoder(response.Body).Decode(tokenInfo)
+	if err != nil {
+		return "", err
+	}
+
+	if tokenInfo.Email != "" {
+		return tokenInfo.Email, nil
+	} else if tokenInfo.Subject != "" {
+		return tokenInfo.Subject, nil
+	}
+
+	return "", errors.New("token info has no email or subject")
+
+}
+
+func credentialsIdentity(ctx context.Context) (string, string, error) {
+
+	cachedIdentity.once.Do(func() {
+		cachedIdentity.identity, cachedIdentity.credentialsType, cachedIdentity.err = resolveIdentity(ctx)
+	})
+
+	return cachedIdentity.identity, cachedIdentity.credentialsType, cachedIdentity.err
+
+}
+
+func resolveIdentity(ctx context.Context) (string, string, error) {
+
+	if appFlag.PublicRequest {
+		return IdentityAnonymous, "none", nil
+	} else if appFlag.AccessToken != "" {
+		identity, err := tokenIdentity(ctx, appFlag.AccessToken)
+		return identity, "access_token", err
+	}
+
+	var credentials *google.Credentials
+	var err error
+	if appFlag.KeyPath != "" {
+		var content []byte
+		content, err = os.ReadFile(appFlag.KeyPath)
+		if err != nil {
+			return "", "", err
+		}
+		credentials, err = google.CredentialsFromJSON(ctx, content, storage.ScopeFullControl)
+	} else {
+		credentials, err = google.FindDefaultCredentials(ctx, storage.ScopeFullControl)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	credentialsType := "metadata_server"
+	if len(credentials.JSON) > 0 {
+		keyFile := new(keyFileStruct)
+		err = json.Unmarshal(credentials.JSON, keyFile)
+		if err != nil {
+			return "", "", err
+		}
+		if keyFile.ClientEmail != "" {
+			return keyFile.ClientEmail, keyFile.Type, nil
+		}
+		credentialsType = keyFile.Type
+	}
+
+	token, err := credentials.TokenSource.Token()
+	if err != nil {
+		return "", credentialsType, err
+	}
+
+	identity, err := tokenIdentity(ctx, token.AccessToken)
+
+	return identity, credentialsType, err
+
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"cloud.google.com/go/storage"
+)
+
+const dedupeSuffixLength = 8
+
+type dedupeMappingStruct struct {
+	filePath   string
+	objectPath string
+	status     string
+}
+
+func dedupeObjectName(objectPath string, sum string) string {
+
+	extension := path.Ext(path.Base(objectPath))
+
+	return strings.TrimSuffix(objectPath, extension) + "-" + sum[:dedupeSuffixLength] + extension
+
+}
+
+func dedupeCollision(filePath string, objectPath string, filesByObject map[string]string) (string, bool) {
+
+	sum, err := fileSHA256(filePath)
+	if err != nil {
+		fatal(classifyError(err), "Cannot compute SHA-256 of requested file! (File: "+filePath+", "+errorDetail(err)+")")
+	}
+
+	candidatePath := objectPath
+	for _, candidate := range []string{objectPath, dedupeObjectName(objectPath, sum)} {
+		otherFilePath, exists := filesByObject[candidate]
+		if !exists {
+			candidatePath = candidate
+			break
+		}
+		otherSum, err := fileSHA256(otherFilePath)
+		if err != nil {
+			fatal(classifyError(err), "Cannot compute SHA-256 of requested file! (File: "+otherFilePath+", "+errorDetail(err)+")")
+		}
+		if otherSum == sum {
+			return candidate, true
+		}
+		if candidate != objectPath {
+			fatal(ErrCategoryUnknown, "Multiple files would be uploaded to same object even with dedupe suffix! (Object: "+candidate+", Files: "+otherFilePath+","+filePath+")")
+		}
+	}
+
+	return candidatePath, false
+
+}
+
+func remoteObjectIdentical(ctx context.Context, obj *storage.ObjectHandle, filePath string) bool {
+
+	objAttrs, err := objectAttrs(ctx, preflightObject(obj))
+	if err == storage.ErrObjectNotExist {
+		return false
+	}
+	if err != nil {
+		fatal(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		fatal(classifyError(err), "Cannot open requested file! (File: "+filePath+", "+errorDetail(err)+")")
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		fatal(classifyError(err), "Cannot stat requested file! (File: "+filePath+", "+errorDetail(err)+")")
+	}
+	if info.Size() != objAttrs.Size {
+		return false
+	}
+
+	crc, err := fileCRC32C(file)
+	if err != nil {
+		fatal(classifyError(err), "Cannot compute CRC32C of requested file! (File: "+filePath+", "+errorDetail(err)+")")
+	}
+
+	return crc == objAttrs.CRC32C
+
+}
+
+func printDedupeMapping(mappings []dedupeMappingStruct) {
+
+	sort.Slice(mappings, func(i, j int) bool {
+		return mappings[i].filePath < mappings[j].filePath
+	})
+
+	for _, mapping := range mappings {
+		LogInfo.Println("INFO: Upload mapping. (File: " + mapping.filePath + ", Object: " + mapping.objectPath + ", Status: " + mapping.status + ")")
+	}
+
+}
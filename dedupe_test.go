@@ -0,0 +1,27 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestDedupeObjectName(t *testing.T) {
+
+	const sum = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	tests := []struct {
+		objectPath string
+		want       string
+	}{
+		{"dir/file.txt", "dir/file-01234567.txt"},
+		{"file.tar.gz", "file.tar-01234567.gz"},
+		{"noext", "noext-01234567"},
+		{"dir.d/noext", "dir.d/noext-01234567"},
+	}
+
+	for _, test := range tests {
+		if got := dedupeObjectName(test.objectPath, sum); got != test.want {
+			t.Errorf("dedupeObjectName(%q) = %q, want %q", test.objectPath, got, test.want)
+		}
+	}
+
+}
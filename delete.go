@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+func readObjectList(objectListPath string) []string {
+
+	file, err := os.Open(objectListPath)
+	if err != nil {
+		fatal(classifyError(err), "Cannot open requested object list! ("+errorDetail(err)+")")
+	}
+	defer file.Close()
+
+	var objectPaths []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" {
+			objectPaths = append(objectPaths, line)
+		}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		fatal(classifyError(err), "Cannot read requested object list! ("+errorDetail(err)+")")
+	}
+
+	return objectPaths
+
+}
+
+func listMatchingObjects(ctx context.Context, bkt *storage.BucketHandle, prefix string, matchPattern string) ([]string, map[string]int64) {
+
+	if prefix == "" {
+		metaIndex := strings.IndexAny(matchPattern, "*?[\\")
+		if metaIndex < 0 {
+			prefix = matchPattern
+		} else {
+			prefix = matchPattern[:metaIndex]
+		}
+	}
+
+	query := &storage.Query{Prefix: prefix}
+	err := query.SetAttrSelection([]string{"Name", "Size"})
+	if err != nil {
+		fatal(classifyError(err), "Cannot create object query! ("+errorDetail(err)+")")
+	}
+
+	var matchedPaths []string
+	matchedSizes := make(map[string]int64)
+	it := bkt.Objects(ctx, query)
+	for {
+		objAttrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			fatal(classifyError(err), "Cannot list objects! ("+errorDetail(err)+")")
+		}
+
+		matched, _ := path.Match(matchPattern, objAttrs.Name)
+		if matched {
+			matchedPaths = append(matchedPaths, objAttrs.Name)
+			matchedSizes[objAttrs.Name] = objAttrs.Size
+		}
+	}
+
+	return matchedPaths, matchedSizes
+
+}
+
+func deleteObjects(storageUnderlyingDataObject *storageUnderlyingDataStruct, bucketName string, objectPaths []string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	bkt := client.Bucket(bucketName)
+
+	if appFlag.ExtraChecks {
+		_, err := bucketAttrs(ctx, preflightBucket(bkt))
+		if err != nil {
+			if err == storage.ErrBucketNotExist {
+				fatal(classifyError(err), "Bucket does not exist! ("+errorDetail(err)+")")
+			} else {
+				fatal(classifyError(err), "Cannot fetch bucket info! ("+errorDetail(err)+")")
+			}
+		}
+	}
+
+	objectSizes := make(map[string]int64)
+	if appFlag.MatchPattern != "" {
+		matchedPaths, matchedSizes := listMatchingObjects(ctx, bkt, appFlag.Prefix, appFlag.MatchPattern)
+		LogInfo.Println("INFO: Objects matched on GCP Bucket. (Matched Objects: " + strconv.Itoa(len(matchedPaths)) + ")")
+
+		if len(matchedPaths) > 0 && !appFlag.Confirm && !appFlag.AssumeYes && !appFlag.DryRun && !isTerminal(os.Stdin) {
+			fatal(ErrCategoryUnknown, "Confirm parameter must be set to delete matched objects when not running interactively!")
+		}
+
+		objectPaths = append(objectPaths, matchedPaths...)
+		objectSizes = matchedSizes
+	}
+
+	if appFlag.DryRun {
+		dryRunDelete(ctx, bkt, objectPaths, objectSizes)
+		return
+	}
+
+	if len(objectPaths) > 0 && !appFlag.Confirm && !appFlag.AssumeYes && isTerminal(os.Stdin) {
+		if !promptConfirm("Delete " + strconv.Itoa(len(objectPaths)) + " objects?") {
+			fatal(ErrCategoryUnknown, "Deleting objects aborted by user!")
+		}
+	}
+
+	var deletedCount, skippedCount, failedCount atomic.Int64
+	var timedOut timedOutList
+	var table summaryTable
+
+	runWorkerPool(int(appFlag.Concurrency), objectPaths, func(objectPath string) {
+		objCtx, objCancel := objectContext(ctx)
+		defer objCancel()
+
+		objStart := time.Now()
+		err := bkt.Object(objectPath).Delete(objCtx)
+		if err != nil {
+			if objectTimedOut(objCtx, err) && ctx.Err() == nil {
+				LogErr.Println("ERROR: Deleting object timed out! (Object: " + objectPath + ", " + errorDetail(err) + ")")
+				timedOut.add(objectPath)
+				failedCount.Add(1)
+				countError(classifyError(err))
+				table.add(objectPath, "TIMED OUT", 0, time.Since(objStart))
+				recordObject(objectPath, RecordTimedOut, 0, "", time.Since(objStart), err)
+			} else if err == storage.ErrObjectNotExist {
+				LogWarn.Println("WARNING: Object does not exist, skipping it! (Object: " + objectPath + ")")
+				skippedCount.Add(1)
+				table.add(objectPath, "SKIPPED", 0, time.Since(objStart))
+				recordObject(objectPath, RecordSkipped, 0, "", time.Since(objStart), nil)
+			} else {
+				LogErr.Println("ERROR: Cannot delete object! (Object: " + objectPath + ", " + errorDetail(err) + ")")
+				failedCount.Add(1)
+				countError(classifyError(err))
+				table.add(objectPath, "FAILED", 0, time.Since(objStart))
+				recordObject(objectPath, RecordFailed, 0, "", time.Since(objStart), err)
+			}
+			return
+		}
+		deletedCount.Add(1)
+		runMetrics.objectsDeleted.Add(1)
+		table.add(objectPath, "DELETED", 0, time.Since(objStart))
+		recordObject(objectPath, RecordDeleted, 0, "", time.Since(objStart), nil)
+	})
+
+	table.print()
+
+	summary := "Deleted Objects: " + strconv.FormatInt(deletedCount.Load(), 10) + ", Skipped Objects: " + strconv.FormatInt(skippedCount.Load(), 10)
+
+	if failedCount.Load() > 0 {
+		if len(timedOut.items) > 0 {
+			summary += ", Timed Out Objects: " + timedOut.String()
+		}
+		fatal(ErrCategoryUnknown, "Cannot delete some objects from GCP Bucket! ("+summary+", Failed Objects: "+strconv.FormatInt(failedCount.Load(), 10)+")")
+	}
+
+	LogInfo.Println("SUCCESS: Objects deleted from GCP Bucket. (" + summary + ")")
+
+}
+
+func dryRunDelete(ctx context.Context, bkt *storage.BucketHandle, objectPaths []string, objectSizes map[string]int64) {
+
+	var missingPaths []string
+	for _, objectPath := range objectPaths {
+		if _, ok := objectSizes[objectPath]; !ok {
+			missingPaths = append(missingPaths, objectPath)
+		}
+	}
+
+	var sizesMutex sync.Mutex
+	notExist := make(map[string]bool)
+	runWorkerPool(int(appFlag.Concurrency), missingPaths, func(objectPath string) {
+		objAttrs, err := objectAttrs(ctx, preflightObject(bkt.Object(objectPath)))
+		if err != nil && err != storage.ErrObjectNotExist {
+			fatal(classifyError(err), "Cannot fetch object info! (Object: "+objectPath+", "+errorDetail(err)+")")
+		}
+		sizesMutex.Lock()
+		if err == storage.ErrObjectNotExist {
+			notExist[objectPath] = true
+		} else {
+			objectSizes[objectPath] = objAttrs.Size
+		}
+		sizesMutex.Unlock()
+	})
+
+	var objectCount, totalSize int64
+	for _, objectPath := range objectPaths {
+		if notExist[objectPath] {
+			LogInfo.Println("DRY RUN: Object does not exist, would be skipped. (Object: " + objectPath + ")")
+			continue
+		}
+		objectCount++
+		totalSize += objectSizes[objectPath]
+		LogInfo.Println("DRY RUN: Object would be deleted. (Object: " + objectPath + ", Size: " + strconv.FormatInt(objectSizes[objectPath], 10) + ")")
+	}
+
+	LogInfo.Println("SUCCESS: Dry run completed, nothing deleted from GCP Bucket. (Would Delete Objects: " + strconv.FormatInt(objectCount, 10) + ", Total Bytes: " + strconv.FormatInt(totalSize, 10) + ", Would Skip Objects: " + strconv.Itoa(len(notExist)) + ")")
+
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+const (
+	DetailMinimal = "minimal"
+	DetailNormal  = "normal"
+	DetailFull    = "full"
+)
+
+func isFullDetail() bool {
+
+	return strings.EqualFold(appFlag.Detail, DetailFull)
+
+}
+
+func logSuccess(message string, detail string, objAttrs *storage.ObjectAttrs) {
+
+	if strings.EqualFold(appFlag.Detail, DetailMinimal) {
+		LogInfo.Println("SUCCESS: " + message)
+		return
+	}
+
+	if isFullDetail() && objAttrs != nil {
+		detail = fullObjectDetail(objAttrs)
+	}
+
+	LogInfo.Println("SUCCESS: " + message + " (" + detail + ")")
+
+}
+
+func fullDetailAttrs(ctx context.Context, obj *storage.ObjectHandle) *storage.ObjectAttrs {
+
+	if !isFullDetail() {
+		return nil
+	}
+
+	objAttrs, err := objectAttrs(ctx, obj)
+	if err != nil {
+		LogWarn.Println("WARNING: Cannot fetch object info for full detail! (" + errorDetail(err) + ")")
+		return nil
+	}
+
+	return objAttrs
+
+}
+
+func fullObjectDetail(objAttrs *storage.ObjectAttrs) string {
+
+	var keys []string
+	for key := range objAttrs.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+objAttrs.Metadata[key])
+	}
+
+	fields := []string{
+		"Object's NAME: " + objAttrs.Name,
+		"SIZE: " + strconv.FormatInt(objAttrs.Size, 10),
+		"CRC32: " + formatCRC32C(objAttrs.CRC32C),
+		"MD5: " + hex.EncodeToString(objAttrs.MD5),
+		"GENERATION: " + strconv.FormatInt(objAttrs.Generation, 10),
+		"METAGENERATION: " + strconv.FormatInt(objAttrs.Metageneration, 10),
+		"CLASS: " + objAttrs.StorageClass,
+		"TYPE: " + objAttrs.ContentType,
+		"ENCODING: " + objAttrs.ContentEncoding,
+		"LANGUAGE: " + objAttrs.ContentLanguage,
+		"CACHE CONTROL: " + objAttrs.CacheControl,
+		"CREATED: " + objAttrs.Created.UTC().Format(time.RFC3339),
+		"UPDATED: " + objAttrs.Updated.UTC().Format(time.RFC3339),
+		"METADATA: " + strings.Join(pairs, ","),
+	}
+
+	return strings.Join(fields, ", ")
+
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"cloud.google.com/go/storage"
+)
+
+const (
+	diffMaxSize    = 256 * 1024
+	diffMaxCells   = 16 * 1024 * 1024
+	diffContextLen = 3
+)
+
+var diffOutput struct {
+	mutex sync.Mutex
+}
+
+type diffOpStruct struct {
+	kind      byte
+	text      string
+	leftLine  int
+	rightLine int
+}
+
+func diffObject(ctx context.Context, obj *storage.ObjectHandle, objAttrs *storage.ObjectAttrs, file *os.File) (bool, error) {
+
+	defer file.Seek(0, io.SeekStart)
+
+	info, err := file.Stat()
+	if err != nil {
+		return false, newCategorizedError(classifyError(err), "Cannot stat requested file! ("+errorDetail(err)+")")
+	}
+
+	if objAttrs.Size <= diffMaxSize && info.Size() <= diffMaxSize {
+		remoteContent, localContent, err := readDiffContents(ctx, obj, file)
+		if err != nil {
+			return false, err
+		}
+		if isTextContent(remoteContent) && isTextContent(localContent) {
+			remoteLines := splitLines(remoteContent)
+			localLines := splitLines(localContent)
+			if len(remoteLines)*len(localLines) <= diffMaxCells {
+				if bytes.Equal(remoteContent, localContent) {
+					LogInfo.Println("INFO: Local file is identical to existing object. (Object: " + objAttrs.Name + ")")
+					return true, nil
+				}
+				diff := unifiedDiff(remoteLines, localLines, "gs://"+objAttrs.Bucket+"/"+objAttrs.Name, file.Name())
+				diffOutput.mutex.Lock()
+				fmt.Print(diff)
+				diffOutput.mutex.Unlock()
+				return false, nil
+			}
+		}
+	}
+
+	crc, err := fileCRC32C(file)
+	if err != nil {
+		return false, newCategorizedError(classifyError(err), "Cannot compute checksum of requested file! ("+errorDetail(err)+")")
+	}
+	if crc == objAttrs.CRC32C {
+		LogInfo.Println("INFO: Local file is identical to existing object by checksum. (Object: " + objAttrs.Name + ", CRC32: " + formatCRC32C(crc) + ")")
+		return true, nil
+	}
+	LogInfo.Println("INFO: Local file differs from existing object by checksum. (Object: " + objAttrs.Name + ", Object's CRC32: " + formatCRC32C(objAttrs.CRC32C) + ", Local File's CRC32: " + formatCRC32C(crc) + ")")
+
+	return false, nil
+
+}
+
+func readDiffContents(ctx context.Context, obj *storage.ObjectHandle, file *os.File) ([]byte, []byte, error) {
+
+	reader, err := openObjectReader(ctx, obj, 0, -1)
+	if err != nil {
+		return nil, nil, newCategorizedError(classifyError(err), "Cannot create new object reader! ("+errorDetail(err)+")")
+	}
+	defer reader.Close()
+
+	remoteContent, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, newCategorizedError(classifyError(err), "Cannot read object from bucket! ("+errorDetail(err)+")")
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, nil, newCategorizedError(classifyError(err), "Cannot seek requested file! ("+errorDetail(err)+")")
+	}
+	localContent, err := io.ReadAll(file)
+	if err != nil {
+		return nil, nil, newCategorizedError(classifyError(err), "Cannot read requested file! ("+errorDetail(err)+")")
+	}
+
+	return remoteContent, localContent, nil
+
+}
+
+func isTextContent(content []byte) bool {
+
+	return utf8.Valid(content) && bytes.IndexByte(content, 0) < 0
+
+}
+
+func splitLines(content []byte) []string {
+
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+
+}
+
+func diffOps(left []string, right []string) []diffOpStruct {
+
+	lcs := make([][]int32, len(left)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(right)+1)
+	}
+	for i := len(left) - 1; i >= 0; i-- {
+		for j := len(right) - 1; j >= 0; j-- {
+			if left[i] == right[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []diffOpStruct
+	i, j := 0, 0
+	for i < len(left) || j < len(right) {
+		if i < len(left) && j < len(right) && left[i] == right[j] {
+			ops = append(ops, diffOpStruct{kind: ' ', text: left[i], leftLine: i, rightLine: j})
+			i++
+			j++
+		} else if j >= len(right) || (i < len(left) && lcs[i+1][j] >= lcs[i][j+1]) {
+			ops = append(ops, diffOpStruct{kind: '-', text: left[i], leftLine: i, rightLine: j})
+			i++
+		} else {
+			ops = append(ops, diffOpStruct{kind: '+', text: right[j], leftLine: i, rightLine: j})
+			j++
+		}
+	}
+
+	return ops
+
+}
+
+func unifiedDiff(left []string, right []string, leftName string, rightName string) string {
+
+	ops := diffOps(left, right)
+
+	var builder strings.Builder
+	builder.WriteString("--- " + leftName + "\n")
+	builder.WriteString("+++ " + rightName + "\n")
+
+	for start := 0; start < len(ops); {
+		if ops[start].kind == ' ' {
+			start++
+			continue
+		}
+
+		end := start
+		for k := start; k < len(ops) && k <= end+2*diffContextLen; k++ {
+			if ops[k].kind != ' ' {
+				end = k
+			}
+		}
+
+		first := max(start-diffContextLen, 0)
+		last := min(end+diffContextLen, len(ops)-1)
+
+		leftCount, rightCount := 0, 0
+		for _, op := range ops[first : last+1] {
+			if op.kind != '+' {
+				leftCount++
+			}
+			if op.kind != '-' {
+				rightCount++
+			}
+		}
+
+		leftStart, rightStart := ops[first].leftLine, ops[first].rightLine
+		if leftCount > 0 {
+			leftStart++
+		}
+		if rightCount > 0 {
+			rightStart++
+		}
+		builder.WriteString("@@ -" + strconv.Itoa(leftStart) + "," + strconv.Itoa(leftCount) + " +" + strconv.Itoa(rightStart) + "," + strconv.Itoa(rightCount) + " @@\n")
+
+		for _, op := range ops[first : last+1] {
+			builder.WriteByte(op.kind)
+			builder.WriteString(strings.TrimSuffix(op.text, "\n") + "\n")
+		}
+
+		start = last + 1
+	}
+
+	return builder.String()
+
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		left  string
+		right string
+		want  string
+	}{
+		{
+			name:  "identical",
+			left:  "a\nb\n",
+			right: "a\nb\n",
+			want:  "--- left\n+++ right\n",
+		},
+		{
+			name:  "changed line",
+			left:  "a\nb\nc\n",
+			right: "a\nx\nc\n",
+			want:  "--- left\n+++ right\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n",
+		},
+		{
+			name:  "added to empty",
+			left:  "",
+			right: "a\n",
+			want:  "--- left\n+++ right\n@@ -0,0 +1,1 @@\n+a\n",
+		},
+		{
+			name:  "removed all",
+			left:  "a\n",
+			right: "",
+			want:  "--- left\n+++ right\n@@ -1,1 +0,0 @@\n-a\n",
+		},
+		{
+			name:  "separate hunks",
+			left:  "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n",
+			right: "x\n2\n3\n4\n5\n6\n7\n8\n9\ny\n",
+			want:  "--- left\n+++ right\n@@ -1,4 +1,4 @@\n-1\n+x\n 2\n 3\n 4\n@@ -7,4 +7,4 @@\n 7\n 8\n 9\n-10\n+y\n",
+		},
+	}
+
+	for _, test := range tests {
+		got := unifiedDiff(splitLines([]byte(test.left)), splitLines([]byte(test.right)), "left", "right")
+		if got != test.want {
+			t.Errorf("%s: unifiedDiff() = %q, want %q", test.name, got, test.want)
+		}
+	}
+
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"hash/crc32"
+	"io"
+	"os"
+
+	"cloud.google.com/go/storage"
+)
+
+func fileHashes(file *os.File) (uint32, []byte, error) {
+
+	_, err := file.Seek(0, io.SeekStart)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	crcHash := crc32.New(crc32cTable)
+	md5Hash := md5.New()
+	_, err = io.Copy(io.MultiWriter(crcHash, md5Hash), file)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return crcHash.Sum32(), md5Hash.Sum(nil), nil
+
+}
+
+func verifyObjectHashes(ctx context.Context, obj *storage.ObjectHandle, crc uint32, md5Sum []byte) error {
+
+	objAttrs, err := objectAttrs(ctx, obj)
+	if err != nil {
+		return newCategorizedError(classifyError(err), "Cannot fetch object info for double verification! ("+errorDetail(err)+")")
+	}
+
+	if objAttrs.CRC32C != crc {
+		return newCategorizedError(ErrCategoryUnknown, "Double verification failed, CRC32C does not match! (Object's CRC32: "+formatCRC32C(objAttrs.CRC32C)+", Local File's CRC32: "+formatCRC32C(crc)+")")
+	}
+	if len(objAttrs.MD5) == 0 {
+		LogWarn.Println("WARNING: Object has no MD5 hash, like composite objects, double verification checked CRC32C only!")
+		return nil
+	}
+	if !bytes.Equal(objAttrs.MD5, md5Sum) {
+		return newCategorizedError(ErrCategoryUnknown, "Double verification failed, MD5 does not match! (Object's MD5: "+hex.EncodeToString(objAttrs.MD5)+", Local File's MD5: "+hex.EncodeToString(md5Sum)+")")
+	}
+
+	LogInfo.Println("INFO: Double verification passed. (CRC32: " + formatCRC32C(crc) + ", MD5: " + hex.EncodeToString(md5Sum) + ")")
+
+	return nil
+
+}
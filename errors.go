@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"net"
+	"net/http"
+	"net/url"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	ErrCategoryAuth         = "AUTH"
+	ErrCategoryNotFound     = "NOT_FOUND"
+	ErrCategoryPrecondition = "PRECONDITION"
+	ErrCategoryNetwork      = "NETWORK"
+	ErrCategoryTimeout      = "TIMEOUT"
+	ErrCategoryIO           = "IO"
+	ErrCategoryUnknown      = "UNKNOWN"
+)
+
+type categorizedError struct {
+	category string
+	message  string
+}
+
+func (e *categorizedError) Error() string {
+
+	return e.message
+
+}
+
+func newCategorizedError(category string, message string) error {
+
+	return &categorizedError{category: category, message: message}
+
+}
+
+var errAlreadyExists = newCategorizedError(ErrCategoryPrecondition, "Object already exists, create-only upload rejected!")
+
+func classifyError(err error) string {
+
+	var categorizedErr *categorizedError
+	if errors.As(err, &categorizedErr) {
+		return categorizedErr.category
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrCategoryTimeout
+	}
+	if errors.Is(err, storage.ErrBucketNotExist) || errors.Is(err, storage.ErrObjectNotExist) {
+		return ErrCategoryNotFound
+	}
+
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		switch apiErr.Code {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return ErrCategoryAuth
+		case http.StatusNotFound:
+			return ErrCategoryNotFound
+		case http.StatusPreconditionFailed, http.StatusConflict:
+			return ErrCategoryPrecondition
+		case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+			return ErrCategoryTimeout
+		}
+		return ErrCategoryUnknown
+	}
+
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) || errors.Is(err, fs.ErrNotExist) || errors.Is(err, fs.ErrPermission) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return ErrCategoryIO
+	}
+
+	var opErr *net.OpError
+	var dnsErr *net.DNSError
+	var urlErr *url.Error
+	if errors.As(err, &opErr) || errors.As(err, &dnsErr) || errors.As(err, &urlErr) {
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return ErrCategoryTimeout
+		}
+		return ErrCategoryNetwork
+	}
+
+	return ErrCategoryUnknown
+
+}
+
+func errorDetail(err error) string {
+
+	return "CATEGORY: " + classifyError(err) + ", " + err.Error()
+
+}
+
+func isPreconditionFailed(err error) bool {
+
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusPreconditionFailed
+
+}
+
+func isRangeNotSatisfiable(err error) bool {
+
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusRequestedRangeNotSatisfiable
+
+}
+
+func exitAlreadyExists() {
+
+	line := "FATAL ERROR: Object already exists, create-only upload rejected! (CATEGORY: " + ErrCategoryPrecondition + ")"
+	LogErr.Println(line)
+	exitRun(ExitCodeAlreadyExists, ErrCategoryPrecondition, &categorizedError{category: ErrCategoryPrecondition, message: line})
+
+}
+
+func exitOverwritten() {
+
+	line := "FATAL ERROR: Object already existed and was overwritten! (CATEGORY: " + ErrCategoryPrecondition + ")"
+	LogErr.Println(line)
+	exitRun(ExitCodeOverwritten, ErrCategoryPrecondition, &categorizedError{category: ErrCategoryPrecondition, message: line})
+
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestClassifyError(t *testing.T) {
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"fatal", &categorizedError{category: ErrCategoryAuth, message: "x"}, ErrCategoryAuth},
+		{"deadline", context.DeadlineExceeded, ErrCategoryTimeout},
+		{"bucket not exist", storage.ErrBucketNotExist, ErrCategoryNotFound},
+		{"object not exist", fmt.Errorf("attrs: %w", storage.ErrObjectNotExist), ErrCategoryNotFound},
+		{"open missing file", &fs.PathError{Op: "open", Path: "/x", Err: syscall.ENOENT}, ErrCategoryIO},
+		{"open denied", &fs.PathError{Op: "open", Path: "/x", Err: syscall.EACCES}, ErrCategoryIO},
+		{"not exist", fmt.Errorf("stat: %w", os.ErrNotExist), ErrCategoryIO},
+		{"permission", fmt.Errorf("write: %w", os.ErrPermission), ErrCategoryIO},
+		{"bare errno", syscall.ENOENT, ErrCategoryIO},
+		{"unexpected eof", io.ErrUnexpectedEOF, ErrCategoryIO},
+		{"googleapi 401", &googleapi.Error{Code: http.StatusUnauthorized}, ErrCategoryAuth},
+		{"googleapi 403", &googleapi.Error{Code: http.StatusForbidden}, ErrCategoryAuth},
+		{"googleapi 404", &googleapi.Error{Code: http.StatusNotFound}, ErrCategoryNotFound},
+		{"googleapi 409", &googleapi.Error{Code: http.StatusConflict}, ErrCategoryPrecondition},
+		{"googleapi 412", &googleapi.Error{Code: http.StatusPreconditionFailed}, ErrCategoryPrecondition},
+		{"googleapi 429", &googleapi.Error{Code: http.StatusTooManyRequests}, ErrCategoryUnknown},
+		{"googleapi 500", &googleapi.Error{Code: http.StatusInternalServerError}, ErrCategoryUnknown},
+		{"googleapi 503", &googleapi.Error{Code: http.StatusServiceUnavailable}, ErrCategoryUnknown},
+		{"googleapi 504", &googleapi.Error{Code: http.StatusGatewayTimeout}, ErrCategoryTimeout},
+		{"dial refused", &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, ErrCategoryNetwork},
+		{"dial timeout", &net.OpError{Op: "dial", Net: "tcp", Err: timeoutError{}}, ErrCategoryTimeout},
+		{"dns", &net.DNSError{Err: "no such host", Name: "storage.googleapis.com"}, ErrCategoryNetwork},
+		{"url", &url.Error{Op: "Get", URL: "https://storage.googleapis.com", Err: errors.New("connection reset")}, ErrCategoryNetwork},
+		{"url timeout", &url.Error{Op: "Get", URL: "https://storage.googleapis.com", Err: timeoutError{}}, ErrCategoryTimeout},
+		{"unknown", errors.New("boom"), ErrCategoryUnknown},
+	}
+
+	for _, test := range tests {
+		if got := classifyError(test.err); got != test.want {
+			t.Errorf("%s: classifyError() = %s, want %s", test.name, got, test.want)
+		}
+	}
+
+}
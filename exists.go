@@ -0,0 +1,39 @@
+package main
+
+import (
+	"strconv"
+
+	"cloud.google.com/go/storage"
+)
+
+func checkObjectExists(storageUnderlyingDataObject *storageUnderlyingDataStruct, bucketName string, objectPath string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	obj := client.Bucket(bucketName).Object(objectPath)
+	if appFlag.Generation > 0 {
+		obj = obj.Generation(appFlag.Generation)
+	}
+
+	objAttrs, err := objectAttrs(ctx, preflightObject(obj))
+	if err != nil {
+		client.Close()
+		cancel()
+		if err == storage.ErrObjectNotExist {
+			recordObject(objectPath, RecordNotFound, 0, "", 0, nil)
+			LogInfo.Println("NOT FOUND: Object does not exist on GCP Bucket. (Object: " + objectPath + ")")
+			exitWithStatus(ExitCodeNotExist, ErrCategoryNotFound)
+		}
+		fatal(classifyError(err), "Cannot check object existence! ("+errorDetail(err)+")")
+	}
+
+	recordObject(objectPath, RecordExists, objAttrs.Size, formatCRC32C(objAttrs.CRC32C), 0, nil)
+
+	LogInfo.Println("SUCCESS: Object exists on GCP Bucket. (Object's SIZE: " + strconv.FormatInt(objAttrs.Size, 10) + ", GENERATION: " + strconv.FormatInt(objAttrs.Generation, 10) + ")")
+
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+const (
+	ExitStatusSuccess = "SUCCESS"
+	ExitStatusError   = "ERROR"
+)
+
+var (
+	finishOnce sync.Once
+	exitOnce   sync.Once
+)
+
+func fatal(category string, message string) {
+
+	line := "FATAL ERROR: " + message
+	LogErr.Output(2, line)
+
+	exitCode := ExitCodeError
+	if appFlag != nil && strings.EqualFold(appFlag.ActionType, Exists) {
+		exitCode = ExitCodeCheckFailed
+	}
+
+	exitRun(exitCode, category, &categorizedError{category: category, message: line})
+
+}
+
+func finishRun(status string, final bool) {
+
+	finishOnce.Do(func() {
+		if appFlag.MetricsPath != "" {
+			writeMetricsFile(appFlag.MetricsPath)
+		}
+
+		if appFlag.RecordPath != "" {
+			closeRecordFile()
+		}
+
+		if appFlag.AuditPath != "" && final {
+			writeAuditLine(appFlag.AuditPath, status)
+		}
+	})
+
+}
+
+func exitRun(code int, status string, err error) {
+
+	exitOnce.Do(func() {
+		if appFlag != nil {
+			final := err == nil || !actionWillRetry(code)
+			if err != nil {
+				countError(status)
+				recordFailedObjects(err)
+			}
+
+			finishRun(status, final)
+
+			if err != nil && appFlag.JSONOutput && final {
+				printErrorResult(status, err)
+			}
+			if appFlag.ExitMessage && final {
+				printExitStatus(status)
+			}
+		}
+
+		os.Exit(code)
+	})
+
+}
+
+func exitWithStatus(code int, status string) {
+
+	exitRun(code, status, nil)
+
+}
+
+func printExitStatus(status string) {
+
+	fmt.Fprintln(stdoutWriter(), "STATUS: "+status)
+
+}
@@ -0,0 +1,20 @@
+//go:build !windows
+
+package main
+
+import (
+	"os"
+	"syscall"
+)
+
+func lockFile(file *os.File) error {
+
+	return syscall.Flock(int(file.Fd()), syscall.LOCK_EX)
+
+}
+
+func unlockFile(file *os.File) error {
+
+	return syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+const (
+	GitCommitKey = "git-commit"
+	GitBranchKey = "git-branch"
+	GitDirtyKey  = "git-dirty"
+)
+
+var gitMetadata map[string]string
+
+func runGit(args ...string) (string, error) {
+
+	output, err := exec.Command("git", args...).Output()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(output)), nil
+
+}
+
+func readGitMetadata() map[string]string {
+
+	commit, err := runGit("rev-parse", "HEAD")
+	if err != nil {
+		LogWarn.Println("WARNING: Cannot read git context, git metadata is skipped! (" + errorDetail(err) + ")")
+		return nil
+	}
+
+	branch, err := runGit("rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		LogWarn.Println("WARNING: Cannot read git branch, git metadata is skipped! (" + errorDetail(err) + ")")
+		return nil
+	}
+
+	status, err := runGit("status", "--porcelain")
+	if err != nil {
+		LogWarn.Println("WARNING: Cannot read git status, git metadata is skipped! (" + errorDetail(err) + ")")
+		return nil
+	}
+
+	metadata := map[string]string{
+		GitCommitKey: commit,
+		GitBranchKey: branch,
+		GitDirtyKey:  strconv.FormatBool(status != ""),
+	}
+
+	LogInfo.Println("INFO: Git context read for object metadata. (Commit: " + commit + ", Branch: " + branch + ", Dirty: " + metadata[GitDirtyKey] + ")")
+
+	return metadata
+
+}
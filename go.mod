@@ -4,6 +4,8 @@ go 1.21.4
 
 require (
 	cloud.google.com/go/storage v1.35.1
+	golang.org/x/oauth2 v0.15.0
+	golang.org/x/sys v0.15.0
 	google.golang.org/api v0.153.0
 )
 
@@ -26,9 +28,7 @@ require (
 	go.opentelemetry.io/otel/trace v1.21.0 // indirect
 	golang.org/x/crypto v0.16.0 // indirect
 	golang.org/x/net v0.19.0 // indirect
-	golang.org/x/oauth2 v0.15.0 // indirect
 	golang.org/x/sync v0.5.0 // indirect
-	golang.org/x/sys v0.15.0 // indirect
 	golang.org/x/text v0.14.0 // indirect
 	golang.org/x/time v0.5.0 // indirect
 	golang.org/x/xerrors v0.0.0-20231012003039-104605ab7028 // indirect
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+)
+
+type indexEntryStruct struct {
+	ModTime int64  `json:"mtime"`
+	Size    int64  `json:"size"`
+	CRC32C  uint32 `json:"crc32c"`
+}
+
+var localIndex struct {
+	mutex   sync.Mutex
+	entries map[string]indexEntryStruct
+	changed bool
+}
+
+func readLocalIndex(indexPath string) {
+
+	localIndex.entries = make(map[string]indexEntryStruct)
+
+	content, err := os.ReadFile(indexPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			LogInfo.Println("INFO: Index file does not exist, it will be created. (Index File: " + indexPath + ")")
+			return
+		}
+		fatal(classifyError(err), "Cannot read index file! ("+errorDetail(err)+")")
+	}
+
+	err = json.Unmarshal(content, &localIndex.entries)
+	if err != nil {
+		LogWarn.Println("WARNING: Cannot parse index file, it will be rebuilt! (" + errorDetail(err) + ")")
+		localIndex.entries = make(map[string]indexEntryStruct)
+	}
+
+	LogDebug.Println("DEBUG: Index file loaded. (Entries: " + strconv.Itoa(len(localIndex.entries)) + ")")
+
+}
+
+func writeLocalIndex(indexPath string) {
+
+	localIndex.mutex.Lock()
+	defer localIndex.mutex.Unlock()
+
+	if !localIndex.changed {
+		return
+	}
+
+	content, err := json.MarshalIndent(localIndex.entries, "", "  ")
+	if err != nil {
+		fatal(classifyError(err), "Cannot encode index file! ("+errorDetail(err)+")")
+	}
+
+	tempPath := indexPath + PartSuffix
+	err = os.WriteFile(tempPath, append(content, '\n'), 0644)
+	if err != nil {
+		fatal(classifyError(err), "Cannot write index file! ("+errorDetail(err)+")")
+	}
+	err = os.Rename(tempPath, indexPath)
+	if err != nil {
+		os.Remove(tempPath)
+		fatal(classifyError(err), "Cannot write index file! ("+errorDetail(err)+")")
+	}
+
+	LogDebug.Println("DEBUG: Index file written. (Entries: " + strconv.Itoa(len(localIndex.entries)) + ")")
+
+}
+
+func indexedFileCRC32C(file *os.File) (uint32, error) {
+
+	if localIndex.entries == nil {
+		return fileCRC32C(file)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	key, err := filepath.Abs(file.Name())
+	if err != nil {
+		return 0, err
+	}
+
+	localIndex.mutex.Lock()
+	entry, ok := localIndex.entries[key]
+	localIndex.mutex.Unlock()
+
+	if ok && entry.ModTime == info.ModTime().UnixNano() && entry.Size == info.Size() {
+		LogDebug.Println("DEBUG: Checksum of requested file taken from index. (File: " + key + ")")
+		return entry.CRC32C, nil
+	}
+
+	crc, err := fileCRC32C(file)
+	if err != nil {
+		return 0, err
+	}
+
+	localIndex.mutex.Lock()
+	localIndex.entries[key] = indexEntryStruct{ModTime: info.ModTime().UnixNano(), Size: info.Size(), CRC32C: crc}
+	localIndex.changed = true
+	localIndex.mutex.Unlock()
+
+	return crc, nil
+
+}
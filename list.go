@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+const (
+	OutputText = "text"
+	OutputJSON = "json"
+	OutputCSV  = "csv"
+)
+
+const (
+	ListOrderGrouped = "grouped"
+	ListOrderSorted  = "sorted"
+)
+
+const listResultBuffer = 256
+
+func listObjects(storageUnderlyingDataObject *storageUnderlyingDataStruct, bucketName string, prefix string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	outputFormat := listOutputFormat()
+
+	var csvWriter *csv.Writer
+	switch outputFormat {
+	case OutputText, OutputJSON:
+	case OutputCSV:
+		csvWriter = csv.NewWriter(os.Stdout)
+		if !appFlag.NoHeader {
+			if appFlag.SoftDeleted {
+				csvWriter.Write([]string{"name", "size", "generation", "softDeleteTime", "hardDeleteTime"})
+			} else {
+				csvWriter.Write([]string{"name", "size", "storageClass", "updated", "crc32c"})
+			}
+		}
+	default:
+		fatal(ErrCategoryUnknown, "Wrong output-format parameter specified!")
+	}
+
+	if appFlag.SoftDeleted {
+		if len(appFlag.Prefixes) > 0 {
+			fatal(ErrCategoryUnknown, "Prefixes parameter cannot be used when soft-deleted is set!")
+		}
+		listSoftDeletedObjects(ctx, bucketName, prefix, outputFormat, csvWriter)
+		return
+	}
+
+	var objectCount, totalSize int64
+
+	printObject := func(objAttrs *storage.ObjectAttrs) {
+		objectCount++
+		totalSize += objAttrs.Size
+
+		switch outputFormat {
+		case OutputText:
+			fmt.Println("OBJECT: " + objAttrs.Name + " (SIZE: " + strconv.FormatInt(objAttrs.Size, 10) + ", CLASS: " + objAttrs.StorageClass + ", UPDATED: " + objAttrs.Updated.UTC().Format(time.RFC3339) + ")")
+		case OutputJSON:
+			printJSON(newObjectStat(objAttrs))
+		case OutputCSV:
+			csvWriter.Write([]string{objAttrs.Name, strconv.FormatInt(objAttrs.Size, 10), objAttrs.StorageClass, objAttrs.Updated.UTC().Format(time.RFC3339), formatCRC32C(objAttrs.CRC32C)})
+		}
+	}
+
+	bkt := client.Bucket(bucketName)
+	if len(appFlag.Prefixes) > 0 {
+		err := listPrefixesConcurrently(ctx, bkt, appFlag.Prefixes, printObject)
+		if err != nil {
+			listFailed(err)
+		}
+	} else {
+		it := bkt.Objects(ctx, listQuery(prefix))
+		for {
+			objAttrs, err := it.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				listFailed(err)
+			}
+			printObject(objAttrs)
+		}
+	}
+
+	if csvWriter != nil {
+		csvWriter.Flush()
+		err := csvWriter.Error()
+		if err != nil {
+			fatal(classifyError(err), "Cannot write CSV output! ("+errorDetail(err)+")")
+		}
+	}
+
+	LogInfo.Println("SUCCESS: Objects listed from GCP Bucket. (Listed Objects: " + strconv.FormatInt(objectCount, 10) + ", Total Size: " + strconv.FormatInt(totalSize, 10) + ")")
+
+}
+
+func listFailed(err error) {
+
+	if err == storage.ErrBucketNotExist {
+		fatal(classifyError(err), "Bucket does not exist! ("+errorDetail(err)+")")
+	} else {
+		fatal(classifyError(err), "Cannot list objects! ("+errorDetail(err)+")")
+	}
+
+}
+
+func listQuery(prefix string) *storage.Query {
+
+	return &storage.Query{Prefix: prefix, StartOffset: appFlag.StartOffset, EndOffset: appFlag.EndOffset}
+
+}
+
+type listResultStruct struct {
+	objAttrs *storage.ObjectAttrs
+	err      error
+}
+
+func listPrefixesConcurrently(ctx context.Context, bkt *storage.BucketHandle, prefixes []string, printObject func(*storage.ObjectAttrs)) error {
+
+	prefixes = reducePrefixes(prefixes)
+
+	listCtx, listCancel := context.WithCancel(ctx)
+	defer listCancel()
+
+	indexes := make([]int, len(prefixes))
+	results := make([]chan listResultStruct, len(prefixes))
+	for i := range prefixes {
+		indexes[i] = i
+		results[i] = make(chan listResultStruct, listResultBuffer)
+	}
+
+	go runWorkerPool(int(appFlag.Concurrency), indexes, func(i int) {
+		defer close(results[i])
+
+		it := bkt.Objects(listCtx, listQuery(prefixes[i]))
+		for {
+			objAttrs, err := it.Next()
+			if err == iterator.Done {
+				return
+			}
+			select {
+			case results[i] <- listResultStruct{objAttrs: objAttrs, err: err}:
+			case <-listCtx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	})
+
+	sorted := strings.EqualFold(appFlag.ListOrder, ListOrderSorted)
+
+	var merged []*storage.ObjectAttrs
+	for i, prefix := range prefixes {
+		var objectCount int
+		for result := range results[i] {
+			if result.err != nil {
+				return result.err
+			}
+			objectCount++
+			if sorted {
+				merged = append(merged, result.objAttrs)
+			} else {
+				printObject(result.objAttrs)
+			}
+		}
+		LogDebug.Println("DEBUG: Prefix listed. (Prefix: " + prefix + ", Listed Objects: " + strconv.Itoa(objectCount) + ")")
+	}
+
+	if sorted {
+		sort.SliceStable(merged, func(i, j int) bool {
+			return merged[i].Name < merged[j].Name
+		})
+		for _, objAttrs := range merged {
+			printObject(objAttrs)
+		}
+	}
+
+	return nil
+
+}
+
+func reducePrefixes(prefixes []string) []string {
+
+	var reduced []string
+	for i, prefix := range prefixes {
+		covered := false
+		for j, other := range prefixes {
+			if i != j && strings.HasPrefix(prefix, other) && (prefix != other || j < i) {
+				covered = true
+				break
+			}
+		}
+		if covered {
+			LogDebug.Println("DEBUG: Prefix is duplicate or covered by another prefix, skipped. (Prefix: " + prefix + ")")
+			continue
+		}
+		reduced = append(reduced, prefix)
+	}
+
+	return reduced
+
+}
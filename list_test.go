@@ -0,0 +1,27 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReducePrefixes(t *testing.T) {
+
+	tests := []struct {
+		prefixes []string
+		want     []string
+	}{
+		{[]string{"a/", "b/"}, []string{"a/", "b/"}},
+		{[]string{"a/", "a/"}, []string{"a/"}},
+		{[]string{"a/b/", "a/", "c/"}, []string{"a/", "c/"}},
+		{[]string{"a/", "a/b/", "a/b/", "ab/"}, []string{"a/", "ab/"}},
+		{[]string{"logs", "logs/2024/", "log"}, []string{"log"}},
+	}
+
+	for _, test := range tests {
+		if got := reducePrefixes(test.prefixes); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("reducePrefixes(%q) = %q, want %q", test.prefixes, got, test.want)
+		}
+	}
+
+}
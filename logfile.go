@@ -0,0 +1,82 @@
+package main
+
+import (
+	"os"
+	"sync"
+)
+
+type rotatingFileWriter struct {
+	mutex   sync.Mutex
+	path    string
+	maxSize int64
+	file    *os.File
+	size    int64
+}
+
+func newRotatingFileWriter(path string, maxSize int64) (*rotatingFileWriter, error) {
+
+	w := &rotatingFileWriter{path: path, maxSize: maxSize}
+
+	err := w.open()
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+
+}
+
+func (w *rotatingFileWriter) open() error {
+
+	file, err := os.OpenFile(w.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	w.file = file
+	w.size = info.Size()
+
+	return nil
+
+}
+
+func (w *rotatingFileWriter) rotate() error {
+
+	err := w.file.Close()
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(w.path, w.path+".1")
+	if err != nil {
+		return err
+	}
+
+	return w.open()
+
+}
+
+func (w *rotatingFileWriter) Write(p []byte) (int, error) {
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.maxSize > 0 && w.size > 0 && w.size+int64(len(p)) > w.maxSize {
+		err := w.rotate()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+
+	return n, err
+
+}
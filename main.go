@@ -1,35 +1,207 @@
 package main
 
 import (
+	"compress/gzip"
 	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
 	"flag"
 	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
 	"log"
 	"os"
+	"path"
+	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"cloud.google.com/go/storage"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
+	raw "google.golang.org/api/storage/v1"
 )
 
 const (
 	Upload   = "upload"
 	Download = "download"
+	Delete   = "delete"
+	MakeBkt  = "mb"
+	SignPol  = "signpolicy"
+	Stat     = "stat"
+	Ping     = "ping"
+	List     = "list"
+	Rename   = "rename"
+	DlVers   = "download-versions"
+	Update   = "update"
+	Compare  = "compare"
+	RwClass  = "rewrite-class"
+	WhoAmI   = "whoami"
+	Exists   = "exists"
+	Verify   = "verify"
 )
 
+const (
+	StdioPath          = "-"
+	defaultTimeout     = 60
+	PartSuffix         = ".part"
+	maxUploadChunks    = 32
+	maxUploadChunkSize = 128 * 1024 * 1024
+)
+
+const AllowedBucketsEnv = "GCP_BUCKET_LOADER_ALLOWED_BUCKETS"
+
+const (
+	ExitCodeError         = 1
+	ExitCodeAlreadyExists = 5
+	ExitCodeOverwritten   = 3
+	ExitCodeNotExist      = 1
+	ExitCodeCheckFailed   = 4
+)
+
+type stringListFlag []string
+
+func (l *stringListFlag) String() string {
+
+	return strings.Join(*l, ",")
+
+}
+
+func (l *stringListFlag) Set(value string) error {
+
+	*l = append(*l, value)
+	return nil
+
+}
+
 type AppFlagStruct struct {
-	ActionType    string
-	FilePath      string
-	BucketName    string
-	ObjectPath    string
-	KeyPath       string
-	ContentType   string
-	ExtraChecks   bool
-	PublicRequest bool
-	TimeoutValue  uint
+	ActionType       string
+	FilePath         string
+	FilePaths        []string
+	BucketName       string
+	ObjectPath       string
+	KeyPath          string
+	ContentType      string
+	ExtraChecks      bool
+	PublicRequest    bool
+	TimeoutValue     uint
+	IfNewer          bool
+	ObjectListPath   string
+	Concurrency      uint
+	CopyBufferSize   uint
+	RequireLocation  string
+	RequireClass     string
+	DeclaredSize     uint64
+	ProjectID        string
+	Location         string
+	BucketACL        string
+	UniformAccess    bool
+	ResumeDownload   bool
+	CreateOnly       bool
+	Expiry           time.Duration
+	MaxSize          uint64
+	NormalizePath    bool
+	LogLevel         string
+	AccessToken      string
+	VerifySize       bool
+	PreservePath     bool
+	Retention        time.Duration
+	EventHold        bool
+	MetricsPath      string
+	Prefix           string
+	MatchPattern     string
+	Confirm          bool
+	DryRun           bool
+	AssumeYes        bool
+	LogFilePath      string
+	LogMaxSize       uint
+	CORSOrigins      string
+	CORSMethods      string
+	CORSMaxAge       time.Duration
+	SplitParts       uint
+	JSONOutput       bool
+	SoftDeleted      bool
+	Generation       int64
+	Metadata         map[string]string
+	MetadataPath     string
+	UseADCFile       bool
+	Grants           []string
+	StrictType       bool
+	MaxRetries       uint
+	IfGenMatch       int64
+	UnsafeRetry      bool
+	PrintLocalHash   bool
+	ExpandEnv        bool
+	PreflightRetries uint
+	SkipUnchanged    bool
+	WriteMeta        bool
+	ReadMeta         bool
+	ExitMessage      bool
+	WebhookURL       string
+	DestObjectPath   string
+	UseGRPC          bool
+	PerObjectTimeout uint
+	Diff             bool
+	ContentAddressed bool
+	URI              string
+	CRCFormat        string
+	StorageClass     string
+	Prefixes         []string
+	ListOrder        string
+	PrettyJSON       bool
+	Hold             bool
+	ReleaseHold      bool
+	Progress         bool
+	TLSMinVersion    string
+	CACertPath       string
+	StartOffset      string
+	EndOffset        string
+	NoBuffer         bool
+	AllowedBuckets   []string
+	Throughput       bool
+	SpecPath         string
+	RetryAction      uint
+	Head             bool
+	CallTimeout      uint
+	AllowTypes       []string
+	SummaryTable     bool
+	Base64Output     bool
+	RequirePrivate   bool
+	RawDownload      bool
+	ManifestObject   string
+	MaxTotalBytes    uint64
+	ContentLanguage  string
+	FailOnOverwrite  bool
+	Detail           string
+	IndexPath        string
+	GitMeta          bool
+	TmpDir           string
+	AuditPath        string
+	OnConflict       string
+	MakePrivate      bool
+	ReadBufferSize   uint
+	Verbose          bool
+	Gzip             bool
+	VerifyRoundTrip  bool
+	RetryCodes       []int
+	RetryOnCodes     string
+	Flatten          bool
+	DoubleVerify     bool
+	RateLimit        uint64
+	RangeStart       int64
+	RangeLength      int64
+	RecordPath       string
+	ExpectedHash     string
+	IdleConnTimeout  time.Duration
+	KeepAlive        time.Duration
+	DedupeSuffix     bool
+	OutputFormat     string
+	NoHeader         bool
 }
 
 type storageUnderlyingDataStruct struct {
@@ -42,6 +214,7 @@ var (
 	LogErr    *log.Logger
 	LogWarn   *log.Logger
 	LogInfo   *log.Logger
+	LogDebug  *log.Logger
 	LogAlways *log.Logger
 )
 
@@ -49,11 +222,29 @@ func init() {
 	LogErr = log.New(os.Stderr, "(GCP-Bucket-Loader) ERROR: ", log.Ldate|log.Ltime|log.Lmicroseconds|log.Lshortfile)
 	LogWarn = log.New(os.Stdout, "(GCP-Bucket-Loader) WARNING: ", log.Ldate|log.Ltime|log.Lmicroseconds|log.Lshortfile)
 	LogInfo = log.New(os.Stdout, "(GCP-Bucket-Loader) INFO: ", log.Ldate|log.Ltime|log.Lmicroseconds|log.Lshortfile)
+	LogDebug = log.New(io.Discard, "(GCP-Bucket-Loader) DEBUG: ", log.Ldate|log.Ltime|log.Lmicroseconds|log.Lshortfile)
 	LogAlways = log.New(os.Stdout, "(GCP-Bucket-Loader) ALWAYS: ", log.Ldate|log.Ltime|log.Lmicroseconds|log.Lshortfile)
 }
 
 var appFlag *AppFlagStruct
 
+func setLogLevel(logLevel string) {
+
+	switch strings.ToLower(logLevel) {
+	case "error":
+		LogWarn.SetOutput(io.Discard)
+		LogInfo.SetOutput(io.Discard)
+	case "warning":
+		LogInfo.SetOutput(io.Discard)
+	case "", "info":
+	case "debug":
+		LogDebug.SetOutput(LogInfo.Writer())
+	default:
+		fatal(ErrCategoryUnknown, "Wrong log-level parameter specified!")
+	}
+
+}
+
 func GetAppFlag() *AppFlagStruct {
 
 	appFlagObject := new(AppFlagStruct)
@@ -67,20 +258,140 @@ func GetAppFlag() *AppFlagStruct {
 
 func parseAppFlag() {
 
-	actionType := flag.String("action", "", "Type of action, which can be either 'upload' or 'download'. (Mandatory)")
-	filePath := flag.String("file", "", "Path of local file will be uploaded or downloaded. (Mandatory)")
-	bucketName := flag.String("bucket", "", "Name of the bucket will be used on GCP. (Mandatory)")
+	actionType := flag.String("action", "", "Type of action, which can be 'upload', 'download', 'delete', 'list', 'mb', 'signpolicy', 'stat', 'ping', 'rename', 'update', 'compare', 'rewrite-class', 'whoami', 'exists', 'verify' or 'download-versions'. (Mandatory)")
+	var filePaths stringListFlag
+	flag.Var(&filePaths, "file", "Path of local file will be uploaded or downloaded, can be set as '-' to upload from stdin, can be repeated or comma separated to upload multiple files under object as prefix. (Mandatory)")
+	bucketName := flag.String("bucket", "", "Name of the bucket will be used on GCP, unless uri is set. (Mandatory)")
+	allowedBuckets := flag.String("allowed-buckets", "", "Comma separated names of buckets which are allowed to be used, can be set via '"+AllowedBucketsEnv+"' environment variable too. (Optional)")
+	uri := flag.String("uri", "", "URI of bucket or object on GCP like 'gs://bucket/path/object', can be used instead of bucket and object. (Optional)")
 	objectPath := flag.String("object", "", "Path of the object will be placed under bucket on GCP. (Mandatory)")
+	destObjectPath := flag.String("dest-object", "", "Path of destination object on GCP when action is rename. (Optional)")
 	keyPath := flag.String("key", "", "Path of local json key file will be used to authenticate on GCP. (Mandatory/Optional)")
+	accessToken := flag.String("access-token", "", "OAuth access token will be used to authenticate on GCP instead of key file, which is not refreshed. (Optional)")
+	useADCFile := flag.Bool("adc-file", false, "Can be set as 'true' to authenticate on GCP with credentials of 'gcloud auth application-default login'. (Optional)")
 	contentType := flag.String("type", "", "Name of IANA Media Type. (Optional)")
+	contentLanguage := flag.String("content-language", "", "BCP-47 language tag like 'en' or 'pt-BR' will be set as Content-Language of uploaded object. (Optional)")
+	storageClass := flag.String("storage-class", "", "Name of storage class like 'NEARLINE' will be set on uploaded object, or on existing object when action is rewrite-class. (Optional)")
 	extraChecks := flag.Bool("extra", false, "Can be set as 'true' to perform bucket and object checks on GCP. (Optional)")
+	diff := flag.Bool("diff", false, "Can be set as 'true' to print differences between local file and existing object when extra is set on upload, identical files are skipped. (Optional)")
+	requirePrivate := flag.Bool("require-private", false, "Can be set as 'true' to abort upload when bucket does not enforce public access prevention and uniform access, when extra is set. (Optional)")
+	requireLocation := flag.String("require-location", "", "Can be set to specify location which bucket must be in when extra is set on upload. (Optional)")
+	requireClass := flag.String("require-class", "", "Can be set to specify default storage class which bucket must have when extra is set on upload. (Optional)")
+	tlsMinVersion := flag.String("tls-min-version", "", "Can be set to '1.2' or '1.3' to specify minimum TLS version for connections to GCP. (Optional)")
+	caCertPath := flag.String("ca-cert", "", "Path of PEM file with additional CA certificates will be trusted for connections to GCP. (Optional)")
+	useGRPC := flag.Bool("grpc", false, "Can be set as 'true' to connect to GCP over gRPC instead of HTTP/JSON, falls back to HTTP on failure. (Optional)")
 	publicRequest := flag.Bool("public", false, "Can be set as 'true' to perform unauthenticated connection to GCP. (Optional)")
-	timeoutValue := flag.Uint("timeout", 0, "Can be set to spesify timeout value in seconds (default 60s) for connection to GCP. (Optional)")
+	timeoutValue := flag.Uint("timeout", defaultTimeout, "Can be set to spesify timeout value in seconds for connection to GCP, '0' disables timeout. (Optional)")
+	callTimeout := flag.Uint("call-timeout", 0, "Can be set to specify timeout value in seconds for each object or bucket info request and object read open on GCP, separate from timeout. (Optional)")
+	perObjectTimeout := flag.Uint("per-object-timeout", 0, "Can be set to specify timeout value in seconds for each object in batch operations, consider setting timeout as '0' with it. (Optional)")
+	head := flag.Bool("head", false, "Can be set as 'true' to only fetch object info without writing local file when action is download. (Optional)")
+	base64Output := flag.Bool("base64", false, "Can be set as 'true' to print object base64 encoded to stdout instead of writing local file when action is download, limited by max-size (default 1MiB). (Optional)")
+	rawDownload := flag.Bool("raw", false, "Can be set as 'true' to download stored bytes of gzip encoded object verbatim instead of decompressing it. (Optional)")
+	ifNewer := flag.Bool("if-newer", false, "Can be set as 'true' to download only when object on GCP is newer than local file. (Optional)")
+	objectListPath := flag.String("object-list", "", "Path of local text file listing objects (one per line) will be deleted under bucket on GCP. (Optional)")
+	summaryTableFlag := flag.Bool("summary-table", false, "Can be set as 'true' to print table of objects with status, bytes and duration to stderr at the end of batch operations. (Optional)")
+	concurrency := flag.Uint("concurrency", 0, "Can be set to specify number of concurrent workers (default 8) for batch operations on GCP. (Optional)")
+	copyBufferSize := flag.Uint("copy-buffer-size", 0, "Can be set to specify size in bytes of pooled copy buffers (default 32768) shared across workers. (Optional)")
+	projectID := flag.String("project", "", "ID of the project will own the bucket created on GCP. (Mandatory for mb)")
+	location := flag.String("location", "", "Location of the bucket will be created on GCP (default US). (Optional)")
+	bucketACL := flag.String("bucket-acl", "", "Name of predefined ACL for the bucket will be created on GCP, like 'project-private' or 'public-read'. (Optional)")
+	uniformAccess := flag.Bool("uniform-access", true, "Can be set as 'false' to disable uniform bucket-level access (recommended to keep enabled) on the bucket will be created on GCP. (Optional)")
+	resumeDownload := flag.Bool("resume", false, "Can be set as 'true' to download via partial file and continue from it if an earlier download was interrupted. (Optional)")
+	failOnOverwrite := flag.Bool("fail-on-overwrite", false, "Can be set as 'true' to exit with code 3 when upload replaced an existing object on GCP. (Optional)")
+	createOnly := flag.Bool("create-only", false, "Can be set as 'true' to upload only when object does not exist on GCP, exits with code 5 if it exists. (Optional)")
+	expiry := flag.Duration("expiry", 0, "Can be set to specify expiry duration (default 15m, max 168h) of signed upload policy for GCP. (Optional)")
+	maxTotalBytes := flag.Uint64("max-total-bytes", 0, "Can be set to specify maximum number of bytes transferred during the run, run is aborted when exceeded. (Optional)")
+	maxSize := flag.Uint64("max-size", 0, "Can be set to specify maximum object size in bytes allowed for download or by signed upload policy on GCP. (Optional)")
+	normalizePath := flag.Bool("normalize-path", false, "Can be set as 'true' to strip leading slash and collapse './' segments of object path on GCP. (Optional)")
+	detail := flag.String("detail", DetailNormal, "Can be set to 'minimal', 'normal' or 'full' to specify how much object info is printed on success of upload or download. (Optional)")
+	indexPath := flag.String("index-file", "", "Path of local index file caching CRC32C of files by modification time and size, to avoid re-hashing unchanged files on skip-if-unchanged. (Optional)")
+	gitMeta := flag.Bool("git-meta", false, "Can be set as 'true' to add git-commit, git-branch and git-dirty metadata from git context of current directory to uploaded object. (Optional)")
+	tmpDir := flag.String("tmp-dir", "", "Path of local directory where '.part' file of download is written instead of next to requested file before it is moved to requested file. (Optional)")
+	auditPath := flag.String("audit-log", "", "Path of local file will be appended with a single audit line of the run, including actor, action, bucket, object, result and bytes. (Optional)")
+	onConflict := flag.String("on-conflict", ConflictOverwrite, "Can be set to 'overwrite', 'skip' or 'rename' to specify what happens when uploaded object exists, rename appends a numeric suffix until a free name is found. (Optional)")
+	makePrivate := flag.Bool("make-private", false, "Can be set as 'true' to remove allUsers and allAuthenticatedUsers access from object ACL after upload or on update action. (Optional)")
+	readBufferSize := flag.Uint("read-buffer-size", 0, "Can be set to specify size in bytes of read buffer used when writing downloaded objects to local file (default copy-buffer-size). (Optional)")
+	verbose := flag.Bool("verbose", false, "Can be set as 'true' to print log messages when action is exists, which is silent otherwise. (Optional)")
+	gzipUpload := flag.Bool("gzip", false, "Can be set as 'true' to compress file with gzip while uploading and store object with 'Content-Encoding: gzip'. (Optional)")
+	verifyRoundTrip := flag.Bool("verify-roundtrip", false, "Can be set as 'true' to download gzip encoded object after upload and compare its decompressed SHA-256 with requested file, which costs an extra download. (Optional)")
+	retryOnCodes := flag.String("retry-on-codes", "", "Comma separated HTTP status codes like '429,500,503' will be retried, other API errors fail fast (default standard transient codes). (Optional)")
+	flatten := flag.Bool("flatten", false, "Can be set as 'true' to upload files of a directory by their base names only, discarding subdirectory structure. (Optional)")
+	doubleVerify := flag.Bool("double-verify", false, "Can be set as 'true' to have both CRC32C and MD5 validated by GCP on upload and checked again afterwards, or both checked against downloaded bytes on download. (Optional)")
+	rateLimit := flag.Uint64("rate-limit", 0, "Can be set to limit transfer rate in bytes per second shared by all workers, halved on SIGUSR1 and doubled on SIGUSR2. (Optional)")
+	rangeStart := flag.Int64("range-start", 0, "Can be set to download object from given byte offset, or last bytes of object when negative, an offset at or past end of object downloads an empty file. (Optional)")
+	rangeLength := flag.Int64("range-length", -1, "Can be set to download at most given number of bytes, fewer bytes are downloaded when object ends before (default to end of object). (Optional)")
+	recordPath := flag.String("record-file", "", "Path of local file will be appended with a record of every processed object, as CSV when it ends with '.csv' or JSON lines otherwise. (Optional)")
+	expectedHash := flag.String("expected-hash", "", "CRC32C in base64 or decimal form, or in hex form prefixed with 'hex:' or '0x', or MD5 prefixed with 'md5:', will be compared with requested file offline when action is verify. (Optional)")
+	idleConnTimeout := flag.Duration("idle-conn-timeout", 0, "Can be set to specify how long (like '5m') idle connections are kept for reuse (default 90s). (Optional)")
+	keepAlive := flag.Duration("keep-alive", 0, "Can be set to specify TCP keep-alive period (like '15s') of connections (default 30s). (Optional)")
+	dedupeSuffix := flag.Bool("dedupe-suffix", false, "Can be set as 'true' to append a short content hash suffix to object names instead of failing when multiple uploaded files map to same object, identical content is skipped. (Optional)")
+	logLevel := flag.String("log-level", "info", "Level of logging, which can be 'error', 'warning', 'info' or 'debug'. (Optional)")
+	verifySize := flag.Bool("verify-size", false, "Can be set as 'true' to verify downloaded bytes match object size on GCP, implied when extra is set. (Optional)")
+	preservePath := flag.Bool("preserve-path", false, "Can be set as 'true' to download object under file directory by mirroring its full path on GCP. (Optional)")
+	retention := flag.Duration("retention", 0, "Can be set to specify retention period (like '720h') of the bucket will be created on GCP. (Optional)")
+	eventHold := flag.Bool("event-hold", false, "Can be set as 'true' to place event-based hold on the object uploaded to GCP. (Optional)")
+	hold := flag.Bool("hold", false, "Can be set as 'true' to place temporary hold on object when action is update. (Optional)")
+	releaseHold := flag.Bool("release-hold", false, "Can be set as 'true' to release temporary hold on object when action is update. (Optional)")
+	metricsPath := flag.String("metrics-file", "", "Path of local file will be written with Prometheus style metrics at the end of run. (Optional)")
+	manifestObject := flag.String("write-manifest", "", "Name of object on GCP will be written with JSON manifest of uploaded objects after all uploads succeed. (Optional)")
+	webhookURL := flag.String("webhook", "", "URL will be notified with JSON payload via POST request after successful upload. (Optional)")
+	exitMessage := flag.Bool("exit-message", false, "Can be set as 'true' to print final status line like 'STATUS: SUCCESS' regardless of log level. (Optional)")
+	prefix := flag.String("prefix", "", "Prefix of objects will be listed, deleted or compared under bucket on GCP, or prefix prepended to object name on upload. (Optional)")
+	startOffset := flag.String("start-offset", "", "Objects with names lexicographically greater than or equal to it will be listed. (Optional)")
+	endOffset := flag.String("end-offset", "", "Objects with names lexicographically less than it will be listed. (Optional)")
+	prefixes := flag.String("prefixes", "", "Comma separated prefixes of objects will be listed concurrently under bucket on GCP, instead of prefix. (Optional)")
+	listOrder := flag.String("list-order", ListOrderGrouped, "Can be set to 'grouped' or 'sorted' to specify ordering of objects listed with prefixes. (Optional)")
+	contentAddressed := flag.Bool("content-addressed", false, "Can be set as 'true' to name uploaded object by SHA-256 of local file, ignoring object parameter. (Optional)")
+	matchPattern := flag.String("match", "", "Glob pattern (like 'tmp/*.log') of objects will be deleted under bucket on GCP. (Optional)")
+	confirm := flag.Bool("confirm", false, "Can be set as 'true' to confirm deleting objects matched on GCP without prompt. (Optional)")
+	dryRun := flag.Bool("dry-run", false, "Can be set as 'true' to preview objects will be deleted on GCP without deleting them. (Optional)")
+	yes := flag.Bool("yes", false, "Can be set as 'true' to skip interactive prompts before overwriting, renaming or deleting objects on GCP. (Optional)")
+	force := flag.Bool("force", false, "Alias of yes parameter. (Optional)")
+	logFilePath := flag.String("log-file", "", "Path of local file will be used to write logs instead of stdout and stderr. (Optional)")
+	logMaxSize := flag.Uint("log-max-size", 0, "Can be set to specify size in megabytes to rotate log file at, keeping one old file. (Optional)")
+	corsOrigins := flag.String("cors-origin", "", "Comma separated origins allowed by CORS config of the bucket will be created on GCP. (Optional)")
+	corsMethods := flag.String("cors-method", "", "Comma separated HTTP methods (default GET) allowed by CORS config of the bucket will be created on GCP. (Optional)")
+	corsMaxAge := flag.Duration("cors-max-age", 0, "Can be set to specify preflight cache duration (like '1h') of CORS config of the bucket will be created on GCP. (Optional)")
+	splitParts := flag.Uint("split-parts", 0, "Can be set to split file into given number of parts (max 32) uploaded concurrently and composed on GCP. (Optional)")
+	jsonOutput := flag.Bool("json", false, "Can be set as 'true' to print result as JSON on stdout. (Optional)")
+	metadata := metadataFlag{}
+	flag.Var(metadata, "meta", "Custom metadata in key=value form of the object will be uploaded to GCP, can be repeated. (Optional)")
+	specPath := flag.String("spec", "", "Path of local JSON file with content type, cache control, content encoding, storage class, metadata and grants of uploaded object, overridden by individual parameters. (Optional)")
+	metadataPath := flag.String("meta-from-file", "", "Path of local json file with custom metadata of the object will be uploaded to GCP, overridden by meta. (Optional)")
+	var grants stringListFlag
+	flag.Var(&grants, "grant", "Access grant in entity:role form (like 'user-alice@example.com:READER') on the object will be uploaded to GCP, can be repeated. (Optional)")
+	allowTypes := flag.String("allow-types", "", "Comma separated media types like 'image/png' or 'image/*' which detected type of uploaded file must match. (Optional)")
+	strictType := flag.Bool("strict-type", false, "Can be set as 'true' to reject malformed or unknown IANA Media Type given by type. (Optional)")
+	prettyJSON := flag.Bool("pretty", false, "Can be set as 'true' to print JSON output indented instead of compact, cannot be used with JSON lines output. (Optional)")
+	outputFormat := flag.String("output-format", "", "Format of list output, which can be 'text', 'json' or 'csv' (default text, or json when json is set). (Optional)")
+	noHeader := flag.Bool("no-header", false, "Can be set as 'true' to omit header row of CSV list output. (Optional)")
+	softDeleted := flag.Bool("soft-deleted", false, "Can be set as 'true' to list soft-deleted objects, or to restore soft-deleted object before download on GCP. (Optional)")
+	generation := flag.Int64("generation", 0, "Can be set to specify generation of the object will be downloaded or restored on GCP. (Optional)")
+	retryAction := flag.Uint("retry-action", 0, "Can be set to specify number of times whole upload or download will be run again from scratch on any failure. (Optional)")
+	maxRetries := flag.Uint("max-retries", 0, "Can be set to limit total number of retries for failed requests during the run on GCP. (Optional)")
+	ifGenMatch := flag.Int64("if-generation-match", 0, "Can be set to upload only when generation of the object on GCP matches given value. (Optional)")
+	unsafeRetry := flag.Bool("allow-unsafe-retry", false, "Can be set as 'true' to retry upload on GCP even without a generation precondition. (Optional)")
+	crcFormat := flag.String("crc-format", CRCFormatDecimal, "Can be set to 'decimal', 'hex' or 'base64' to specify how CRC32C values are printed. (Optional)")
+	throughput := flag.Bool("throughput", false, "Can be set as 'true' to log bytes, elapsed time and MB/s of upload transfer. (Optional)")
+	progress := flag.Bool("progress", false, "Can be set as 'true' to log progress of upload or download periodically. (Optional)")
+	printLocalHash := flag.Bool("print-local-hash", false, "Can be set as 'true' to compute and log CRC32C and MD5 of local file while uploading to GCP. (Optional)")
+	expandEnv := flag.Bool("expand-env", false, "Can be set as 'true' to expand environment variables like '$BUILD_ID' in file, bucket, object, type and uri. (Optional)")
+	preflightRetries := flag.Uint("preflight-retries", 0, "Can be set to limit number of retries for bucket and object checks done by extra on GCP. (Optional)")
+	skipUnchanged := flag.Bool("skip-if-unchanged", false, "Can be set as 'true' to skip upload when CRC32C of local file matches the object on GCP. (Optional)")
+	writeMeta := flag.Bool("write-meta", false, "Can be set as 'true' to write object info into '.meta.json' file next to downloaded file. (Optional)")
+	readMeta := flag.Bool("read-meta", false, "Can be set as 'true' to apply object info from '.meta.json' file next to uploaded file. (Optional)")
+	noBuffer := flag.Bool("no-buffer", false, "Can be set as 'true' to upload in single request without chunk buffering, which disables resumable upload. (Optional)")
+	declaredSize := flag.Uint64("size", 0, "Can be set to declare size in bytes of data read from stdin to tune chunking of upload to GCP. (Optional)")
 
 	flag.Parse()
 
 	appFlag.ActionType = *actionType
-	appFlag.FilePath = *filePath
+	for _, value := range filePaths {
+		appFlag.FilePaths = append(appFlag.FilePaths, splitList(value)...)
+	}
+	if len(appFlag.FilePaths) > 0 {
+		appFlag.FilePath = appFlag.FilePaths[0]
+	}
 	appFlag.BucketName = *bucketName
 	appFlag.ObjectPath = *objectPath
 	appFlag.KeyPath = *keyPath
@@ -88,78 +399,830 @@ func parseAppFlag() {
 	appFlag.ExtraChecks = *extraChecks
 	appFlag.PublicRequest = *publicRequest
 	appFlag.TimeoutValue = *timeoutValue
+	appFlag.IfNewer = *ifNewer
+	appFlag.ObjectListPath = *objectListPath
+	appFlag.Concurrency = *concurrency
+	appFlag.CopyBufferSize = *copyBufferSize
+	appFlag.RequireLocation = *requireLocation
+	appFlag.RequireClass = *requireClass
+	appFlag.DeclaredSize = *declaredSize
+	appFlag.ProjectID = *projectID
+	appFlag.Location = *location
+	appFlag.BucketACL = *bucketACL
+	appFlag.UniformAccess = *uniformAccess
+	appFlag.ResumeDownload = *resumeDownload
+	appFlag.CreateOnly = *createOnly
+	appFlag.Expiry = *expiry
+	appFlag.MaxSize = *maxSize
+	appFlag.NormalizePath = *normalizePath
+	appFlag.LogLevel = *logLevel
+	appFlag.AccessToken = *accessToken
+	appFlag.VerifySize = *verifySize
+	appFlag.PreservePath = *preservePath
+	appFlag.Retention = *retention
+	appFlag.EventHold = *eventHold
+	appFlag.MetricsPath = *metricsPath
+	appFlag.Prefix = *prefix
+	appFlag.MatchPattern = *matchPattern
+	appFlag.Confirm = *confirm
+	appFlag.DryRun = *dryRun
+	appFlag.AssumeYes = *yes || *force
+	appFlag.LogFilePath = *logFilePath
+	appFlag.LogMaxSize = *logMaxSize
+	appFlag.CORSOrigins = *corsOrigins
+	appFlag.CORSMethods = *corsMethods
+	appFlag.CORSMaxAge = *corsMaxAge
+	appFlag.SplitParts = *splitParts
+	appFlag.JSONOutput = *jsonOutput
+	appFlag.SoftDeleted = *softDeleted
+	appFlag.Generation = *generation
+	appFlag.Metadata = metadata
+	appFlag.MetadataPath = *metadataPath
+	appFlag.UseADCFile = *useADCFile
+	appFlag.Grants = grants
+	appFlag.StrictType = *strictType
+	appFlag.MaxRetries = *maxRetries
+	appFlag.IfGenMatch = *ifGenMatch
+	appFlag.UnsafeRetry = *unsafeRetry
+	appFlag.PrintLocalHash = *printLocalHash
+	appFlag.ExpandEnv = *expandEnv
+	appFlag.PreflightRetries = *preflightRetries
+	appFlag.SkipUnchanged = *skipUnchanged
+	appFlag.WriteMeta = *writeMeta
+	appFlag.ReadMeta = *readMeta
+	appFlag.ExitMessage = *exitMessage
+	appFlag.WebhookURL = *webhookURL
+	appFlag.DestObjectPath = *destObjectPath
+	appFlag.UseGRPC = *useGRPC
+	appFlag.PerObjectTimeout = *perObjectTimeout
+	appFlag.Diff = *diff
+	appFlag.ContentAddressed = *contentAddressed
+	appFlag.URI = *uri
+	appFlag.CRCFormat = *crcFormat
+	appFlag.StorageClass = *storageClass
+	appFlag.Prefixes = splitList(*prefixes)
+	appFlag.ListOrder = *listOrder
+	appFlag.PrettyJSON = *prettyJSON
+	appFlag.Hold = *hold
+	appFlag.ReleaseHold = *releaseHold
+	appFlag.Progress = *progress
+	appFlag.TLSMinVersion = *tlsMinVersion
+	appFlag.CACertPath = *caCertPath
+	appFlag.StartOffset = *startOffset
+	appFlag.EndOffset = *endOffset
+	appFlag.NoBuffer = *noBuffer
+	appFlag.Throughput = *throughput
+	appFlag.SpecPath = *specPath
+	appFlag.RetryAction = *retryAction
+	appFlag.Head = *head
+	appFlag.CallTimeout = *callTimeout
+	appFlag.AllowTypes = splitList(*allowTypes)
+	appFlag.SummaryTable = *summaryTableFlag
+	appFlag.Base64Output = *base64Output
+	appFlag.RequirePrivate = *requirePrivate
+	appFlag.RawDownload = *rawDownload
+	appFlag.ManifestObject = *manifestObject
+	appFlag.MaxTotalBytes = *maxTotalBytes
+	appFlag.ContentLanguage = *contentLanguage
+	appFlag.FailOnOverwrite = *failOnOverwrite
+	appFlag.Detail = *detail
+	appFlag.IndexPath = *indexPath
+	appFlag.GitMeta = *gitMeta
+	appFlag.TmpDir = *tmpDir
+	appFlag.AuditPath = *auditPath
+	appFlag.OnConflict = *onConflict
+	appFlag.MakePrivate = *makePrivate
+	appFlag.ReadBufferSize = *readBufferSize
+	appFlag.Verbose = *verbose
+	appFlag.Gzip = *gzipUpload
+	appFlag.VerifyRoundTrip = *verifyRoundTrip
+	appFlag.Flatten = *flatten
+	appFlag.DoubleVerify = *doubleVerify
+	appFlag.RateLimit = *rateLimit
+	appFlag.RangeStart = *rangeStart
+	appFlag.RangeLength = *rangeLength
+	appFlag.RecordPath = *recordPath
+	appFlag.ExpectedHash = *expectedHash
+	appFlag.IdleConnTimeout = *idleConnTimeout
+	appFlag.KeepAlive = *keepAlive
+	appFlag.DedupeSuffix = *dedupeSuffix
+	appFlag.RetryOnCodes = *retryOnCodes
+	appFlag.AllowedBuckets = splitList(*allowedBuckets)
+	if len(appFlag.AllowedBuckets) == 0 {
+		appFlag.AllowedBuckets = splitList(os.Getenv(AllowedBucketsEnv))
+	}
+	appFlag.OutputFormat = *outputFormat
+	appFlag.NoHeader = *noHeader
 
 }
 
 func main() {
 
 	start := time.Now()
-	LogAlways.Println("HELLO MSG: Welcome to GCP-Bucket-Loader v2.1 by EY!")
+	runMetrics.startTime = start
 
 	appFlag = GetAppFlag()
 
-	if appFlag.ActionType == "" || appFlag.FilePath == "" || appFlag.BucketName == "" || appFlag.ObjectPath == "" {
-		LogErr.Fatalln("FATAL ERROR: All mandatory parameters must be filled!")
+	if strings.EqualFold(appFlag.ActionType, Exists) && !appFlag.Verbose {
+		for _, logger := range []*log.Logger{LogWarn, LogInfo, LogAlways} {
+			logger.SetOutput(io.Discard)
+		}
+	}
+	keepStdoutForOutput()
+
+	LogAlways.Println("HELLO MSG: Welcome to GCP-Bucket-Loader v2.1 by EY!")
+
+	if appFlag.LogFilePath != "" {
+		logWriter, err := newRotatingFileWriter(appFlag.LogFilePath, int64(appFlag.LogMaxSize)*1024*1024)
+		if err != nil {
+			fatal(classifyError(err), "Cannot open requested log file! ("+errorDetail(err)+")")
+		}
+		for _, logger := range []*log.Logger{LogErr, LogWarn, LogInfo, LogAlways} {
+			logger.SetOutput(logWriter)
+		}
+	}
+
+	setLogLevel(appFlag.LogLevel)
+
+	if appFlag.ExpandEnv {
+		for i := range appFlag.FilePaths {
+			appFlag.FilePaths[i] = os.ExpandEnv(appFlag.FilePaths[i])
+		}
+		if len(appFlag.FilePaths) > 0 {
+			appFlag.FilePath = appFlag.FilePaths[0]
+		}
+		appFlag.BucketName = os.ExpandEnv(appFlag.BucketName)
+		appFlag.ObjectPath = os.ExpandEnv(appFlag.ObjectPath)
+		appFlag.ContentType = os.ExpandEnv(appFlag.ContentType)
+		appFlag.URI = os.ExpandEnv(appFlag.URI)
+		LogDebug.Println("DEBUG: Environment variables expanded. (File: " + appFlag.FilePath + ", Bucket: " + appFlag.BucketName + ", Object: " + appFlag.ObjectPath + ", Type: " + appFlag.ContentType + ")")
+	}
+
+	if !strings.EqualFold(appFlag.Detail, DetailMinimal) && !strings.EqualFold(appFlag.Detail, DetailNormal) && !strings.EqualFold(appFlag.Detail, DetailFull) {
+		fatal(ErrCategoryUnknown, "Wrong detail parameter specified!")
+	}
+	if !strings.EqualFold(appFlag.CRCFormat, CRCFormatDecimal) && !strings.EqualFold(appFlag.CRCFormat, CRCFormatHex) && !strings.EqualFold(appFlag.CRCFormat, CRCFormatBase64) {
+		fatal(ErrCategoryUnknown, "Wrong crc-format parameter specified!")
+	}
+
+	if appFlag.TLSMinVersion != "" || appFlag.CACertPath != "" {
+		_, err := createTLSConfig()
+		if err != nil {
+			fatal(classifyError(err), "Wrong tls-min-version or ca-cert parameter specified! ("+errorDetail(err)+")")
+		}
+		if appFlag.UseGRPC {
+			LogWarn.Println("WARNING: Grpc parameter is unnessary and discarded when tls-min-version or ca-cert is set!")
+			appFlag.UseGRPC = false
+		}
+	}
+	if !strings.EqualFold(appFlag.ListOrder, ListOrderGrouped) && !strings.EqualFold(appFlag.ListOrder, ListOrderSorted) {
+		fatal(ErrCategoryUnknown, "Wrong list-order parameter specified!")
+	}
+	if appFlag.PrettyJSON && strings.EqualFold(appFlag.ActionType, List) && listOutputFormat() == OutputJSON {
+		fatal(ErrCategoryUnknown, "Pretty parameter cannot be used when objects are listed as JSON lines!")
+	}
+	if appFlag.PrettyJSON && strings.EqualFold(appFlag.ActionType, Upload) && uploadsMultipleFiles(appFlag.FilePaths) && appFlag.Throughput && appFlag.JSONOutput {
+		fatal(ErrCategoryUnknown, "Pretty parameter cannot be used when throughput of multiple files is reported as JSON lines!")
+	}
+	if appFlag.StartOffset != "" || appFlag.EndOffset != "" {
+		if !strings.EqualFold(appFlag.ActionType, List) {
+			LogWarn.Println("WARNING: Start-offset and end-offset parameters are unnessary and discarded when action is not list!")
+		} else if appFlag.StartOffset != "" && appFlag.EndOffset != "" && appFlag.StartOffset >= appFlag.EndOffset {
+			fatal(ErrCategoryUnknown, "Start-offset parameter must be less than end-offset parameter!")
+		}
+	}
+	if len(appFlag.Prefixes) > 0 && !strings.EqualFold(appFlag.ActionType, List) {
+		LogWarn.Println("WARNING: Prefixes parameter is unnessary and discarded when action is not list!")
+	} else if len(appFlag.Prefixes) > 0 && appFlag.Prefix != "" {
+		LogWarn.Println("WARNING: Prefix parameter is unnessary and discarded when prefixes is set!")
+	}
+
+	if appFlag.URI != "" {
+		if appFlag.BucketName != "" || appFlag.ObjectPath != "" {
+			fatal(ErrCategoryUnknown, "Uri parameter cannot be used together with bucket or object parameters!")
+		}
+		bucketName, objectPath, err := parseGSURI(appFlag.URI)
+		if err != nil {
+			fatal(classifyError(err), "Wrong uri parameter specified! ("+errorDetail(err)+")")
+		}
+		appFlag.BucketName = bucketName
+		appFlag.ObjectPath = objectPath
+	}
+
+	if appFlag.ActionType == "" || (appFlag.BucketName == "" && !strings.EqualFold(appFlag.ActionType, WhoAmI) && !offlineVerify()) {
+		fatal(ErrCategoryUnknown, "All mandatory parameters must be filled!")
+	}
+	if strings.EqualFold(appFlag.ActionType, Delete) {
+		if appFlag.ObjectPath == "" && appFlag.ObjectListPath == "" && appFlag.MatchPattern == "" {
+			fatal(ErrCategoryUnknown, "Object, object-list or match parameter must be filled when action is delete!")
+		}
+		if appFlag.MatchPattern != "" {
+			_, err := path.Match(appFlag.MatchPattern, "")
+			if err != nil {
+				fatal(classifyError(err), "Wrong match parameter specified! ("+errorDetail(err)+")")
+			}
+		}
+	} else if strings.EqualFold(appFlag.ActionType, MakeBkt) {
+		if appFlag.ProjectID == "" {
+			fatal(ErrCategoryUnknown, "Project parameter must be filled when action is mb!")
+		}
+		if appFlag.Retention < 0 {
+			fatal(ErrCategoryUnknown, "Retention parameter must be a positive duration!")
+		}
+		if appFlag.BucketACL != "" && appFlag.UniformAccess {
+			fatal(ErrCategoryUnknown, "Bucket-acl parameter cannot be used when uniform-access is set, set uniform-access as 'false' to use ACLs!")
+		}
+	} else if strings.EqualFold(appFlag.ActionType, Stat) || strings.EqualFold(appFlag.ActionType, Exists) {
+		if appFlag.ObjectPath == "" {
+			fatal(ErrCategoryUnknown, "All mandatory parameters must be filled!")
+		}
+	} else if strings.EqualFold(appFlag.ActionType, Update) {
+		if appFlag.ObjectPath == "" {
+			fatal(ErrCategoryUnknown, "All mandatory parameters must be filled!")
+		}
+		if appFlag.Hold && appFlag.ReleaseHold {
+			fatal(ErrCategoryUnknown, "Hold and release-hold parameters cannot be used together!")
+		}
+		if !appFlag.Hold && !appFlag.ReleaseHold && !appFlag.MakePrivate {
+			fatal(ErrCategoryUnknown, "Hold, release-hold or make-private parameter must be set when action is update!")
+		}
+	} else if strings.EqualFold(appFlag.ActionType, Rename) {
+		if appFlag.ObjectPath == "" || appFlag.DestObjectPath == "" {
+			fatal(ErrCategoryUnknown, "Object and dest-object parameters must be filled when action is rename!")
+		}
+	} else if strings.EqualFold(appFlag.ActionType, RwClass) {
+		if appFlag.ObjectPath == "" || appFlag.StorageClass == "" {
+			fatal(ErrCategoryUnknown, "Object and storage-class parameters must be filled when action is rewrite-class!")
+		}
+	} else if strings.EqualFold(appFlag.ActionType, Verify) {
+		if appFlag.FilePath == "" || appFlag.FilePath == StdioPath || (appFlag.ObjectPath == "" && appFlag.ExpectedHash == "") {
+			fatal(ErrCategoryUnknown, "File and object or expected-hash parameters must be filled when action is verify!")
+		}
+	} else if strings.EqualFold(appFlag.ActionType, Compare) {
+		if appFlag.FilePath == "" || appFlag.FilePath == StdioPath {
+			fatal(ErrCategoryUnknown, "File parameter must be filled with a local directory when action is compare!")
+		}
+		if appFlag.ObjectPath != "" && appFlag.Prefix == "" {
+			appFlag.Prefix = appFlag.ObjectPath
+			appFlag.ObjectPath = ""
+		}
+	} else if strings.EqualFold(appFlag.ActionType, SignPol) {
+		if appFlag.ObjectPath == "" {
+			fatal(ErrCategoryUnknown, "All mandatory parameters must be filled!")
+		}
+		if appFlag.PublicRequest {
+			fatal(ErrCategoryUnknown, "Public parameter cannot be used when action is signpolicy!")
+		}
+	} else if strings.EqualFold(appFlag.ActionType, Upload) && uploadsMultipleFiles(appFlag.FilePaths) {
+		if slices.Contains(appFlag.FilePaths, StdioPath) {
+			fatal(ErrCategoryUnknown, "Stdin cannot be uploaded together with multiple files!")
+		}
+	} else if strings.EqualFold(appFlag.ActionType, Upload) && appFlag.ContentAddressed {
+		if appFlag.FilePath == "" {
+			fatal(ErrCategoryUnknown, "All mandatory parameters must be filled!")
+		}
+		if appFlag.FilePath == StdioPath {
+			fatal(ErrCategoryUnknown, "Content-addressed parameter cannot be used when uploading from stdin!")
+		}
+	} else if strings.EqualFold(appFlag.ActionType, Download) && (appFlag.Head || appFlag.Base64Output) {
+		if appFlag.ObjectPath == "" {
+			fatal(ErrCategoryUnknown, "All mandatory parameters must be filled!")
+		}
+	} else if !strings.EqualFold(appFlag.ActionType, Ping) && !strings.EqualFold(appFlag.ActionType, List) && !strings.EqualFold(appFlag.ActionType, WhoAmI) && (appFlag.FilePath == "" || appFlag.ObjectPath == "") {
+		fatal(ErrCategoryUnknown, "All mandatory parameters must be filled!")
+	}
+
+	if len(appFlag.AllowedBuckets) > 0 && appFlag.BucketName != "" && !slices.Contains(appFlag.AllowedBuckets, appFlag.BucketName) {
+		fatal(ErrCategoryUnknown, "Bucket is not in allowed buckets! (Bucket: "+appFlag.BucketName+", Allowed Buckets: "+strings.Join(appFlag.AllowedBuckets, ",")+")")
+	}
+
+	if appFlag.UseADCFile && !appFlag.PublicRequest && appFlag.AccessToken == "" {
+		adcPath, err := adcFilePath()
+		if err != nil {
+			fatal(classifyError(err), "Cannot find application default credentials file, run 'gcloud auth application-default login' first! ("+errorDetail(err)+")")
+		}
+		if appFlag.KeyPath != "" {
+			LogWarn.Println("WARNING: Key parameter is unnessary and discarded when adc-file is set!")
+		}
+		LogDebug.Println("DEBUG: Application default credentials file resolved. (Key Path: " + adcPath + ")")
+		appFlag.KeyPath = adcPath
 	}
 
-	if !appFlag.PublicRequest && appFlag.KeyPath == "" {
-		LogErr.Fatalln("FATAL ERROR: Key parameter is mandatory when public is not set!")
+	if !appFlag.PublicRequest && appFlag.KeyPath == "" && appFlag.AccessToken == "" && !offlineVerify() && !strings.EqualFold(appFlag.ActionType, WhoAmI) {
+		fatal(ErrCategoryUnknown, "Key or access-token parameter is mandatory when public is not set!")
 	}
 	if appFlag.PublicRequest && appFlag.KeyPath != "" {
 		LogWarn.Println("WARNING: Key parameter is unnessary and discarded when public is set!")
 	}
+	if appFlag.PublicRequest && appFlag.AccessToken != "" {
+		LogWarn.Println("WARNING: Access-token parameter is unnessary and discarded when public is set!")
+	}
+	if !appFlag.PublicRequest && appFlag.AccessToken != "" {
+		if appFlag.KeyPath != "" {
+			LogWarn.Println("WARNING: Key parameter is unnessary and discarded when access-token is set!")
+		}
+		LogWarn.Println("WARNING: Access token is not refreshed, it must stay valid for the whole run!")
+	}
+	if appFlag.Base64Output && !strings.EqualFold(appFlag.ActionType, Download) {
+		LogWarn.Println("WARNING: Base64 parameter is unnessary and discarded when action is not download!")
+	}
+	if appFlag.Base64Output && appFlag.Head {
+		fatal(ErrCategoryUnknown, "Base64 and head parameters cannot be used together!")
+	}
+	if appFlag.Head && !strings.EqualFold(appFlag.ActionType, Download) {
+		LogWarn.Println("WARNING: Head parameter is unnessary and discarded when action is not download!")
+	}
+	if appFlag.IfNewer && !strings.EqualFold(appFlag.ActionType, Download) {
+		LogWarn.Println("WARNING: If-newer parameter is unnessary and discarded when action is not download!")
+	}
+	if appFlag.ResumeDownload && !strings.EqualFold(appFlag.ActionType, Download) {
+		LogWarn.Println("WARNING: Resume parameter is unnessary and discarded when action is not download!")
+	}
+	if appFlag.CreateOnly && !strings.EqualFold(appFlag.ActionType, Upload) {
+		LogWarn.Println("WARNING: Create-only parameter is unnessary and discarded when action is not upload!")
+	}
+	if len(appFlag.FilePaths) > 1 && !strings.EqualFold(appFlag.ActionType, Upload) {
+		LogWarn.Println("WARNING: File parameters except first are unnessary and discarded when action is not upload!")
+	}
+	if appFlag.MakePrivate && !strings.EqualFold(appFlag.ActionType, Upload) && !strings.EqualFold(appFlag.ActionType, Update) {
+		LogWarn.Println("WARNING: Make-private parameter is unnessary and discarded when action is not upload or update!")
+	}
+	if appFlag.MakePrivate && slices.ContainsFunc(appFlag.Grants, func(grant string) bool {
+		return strings.HasPrefix(grant, string(storage.AllUsers)+":") || strings.HasPrefix(grant, string(storage.AllAuthenticatedUsers)+":")
+	}) {
+		fatal(ErrCategoryUnknown, "Make-private parameter cannot be used together with public grant parameters!")
+	}
+	if appFlag.FailOnOverwrite && !strings.EqualFold(appFlag.ActionType, Upload) {
+		LogWarn.Println("WARNING: Fail-on-overwrite parameter is unnessary and discarded when action is not upload!")
+	}
+	if !strings.EqualFold(appFlag.OnConflict, ConflictOverwrite) && !strings.EqualFold(appFlag.OnConflict, ConflictSkip) && !strings.EqualFold(appFlag.OnConflict, ConflictRename) {
+		fatal(ErrCategoryUnknown, "Wrong on-conflict parameter specified!")
+	}
+	if !strings.EqualFold(appFlag.OnConflict, ConflictOverwrite) && !strings.EqualFold(appFlag.ActionType, Upload) {
+		LogWarn.Println("WARNING: On-conflict parameter is unnessary and discarded when action is not upload!")
+		appFlag.OnConflict = ConflictOverwrite
+	}
+	if !strings.EqualFold(appFlag.OnConflict, ConflictOverwrite) && (appFlag.CreateOnly || appFlag.IfGenMatch > 0) {
+		fatal(ErrCategoryUnknown, "On-conflict parameter cannot be used together with create-only or if-generation-match parameters!")
+	}
+	if !strings.EqualFold(appFlag.OnConflict, ConflictOverwrite) && appFlag.SplitParts > 1 {
+		fatal(ErrCategoryUnknown, "On-conflict parameter cannot be used together with split-parts parameter!")
+	}
+	if appFlag.CreateOnly && appFlag.IfGenMatch > 0 {
+		fatal(ErrCategoryUnknown, "Create-only and if-generation-match parameters cannot be used together!")
+	}
+	if appFlag.MaxRetries > 0 && strings.EqualFold(appFlag.ActionType, Upload) && !appFlag.CreateOnly && appFlag.IfGenMatch == 0 && strings.EqualFold(appFlag.OnConflict, ConflictOverwrite) && !appFlag.UnsafeRetry {
+		LogWarn.Println("WARNING: Upload will not be retried for safety without a generation precondition, consider setting if-generation-match or create-only!")
+	}
+	if appFlag.Diff && (!appFlag.ExtraChecks || !strings.EqualFold(appFlag.ActionType, Upload)) {
+		LogWarn.Println("WARNING: Diff parameter is unnessary and discarded when not uploading with extra!")
+	}
+	if appFlag.RequirePrivate && (!appFlag.ExtraChecks || !strings.EqualFold(appFlag.ActionType, Upload)) {
+		LogWarn.Println("WARNING: Require-private parameter is unnessary and discarded when not uploading with extra!")
+	}
+	if (appFlag.RequireLocation != "" || appFlag.RequireClass != "") && (!appFlag.ExtraChecks || !strings.EqualFold(appFlag.ActionType, Upload)) {
+		LogWarn.Println("WARNING: Require-location and require-class parameters are unnessary and discarded when not uploading with extra!")
+	}
+	if appFlag.NoBuffer && !strings.EqualFold(appFlag.ActionType, Upload) {
+		LogWarn.Println("WARNING: No-buffer parameter is unnessary and discarded when action is not upload!")
+	}
+	if len(appFlag.AllowTypes) > 0 && !strings.EqualFold(appFlag.ActionType, Upload) {
+		LogWarn.Println("WARNING: Allow-types parameter is unnessary and discarded when action is not upload!")
+	} else if len(appFlag.AllowTypes) > 0 && appFlag.FilePath == StdioPath {
+		fatal(ErrCategoryUnknown, "Allow-types parameter cannot be used when uploading from stdin!")
+	}
+	if appFlag.DeclaredSize > 0 && (appFlag.FilePath != StdioPath || !strings.EqualFold(appFlag.ActionType, Upload)) {
+		LogWarn.Println("WARNING: Size parameter is unnessary and discarded when not uploading from stdin!")
+	}
+
+	if appFlag.SpecPath != "" {
+		if !strings.EqualFold(appFlag.ActionType, Upload) {
+			LogWarn.Println("WARNING: Spec parameter is unnessary and discarded when action is not upload!")
+		} else {
+			uploadSpec = readUploadSpec(appFlag.SpecPath)
+			if appFlag.ContentType == "" {
+				appFlag.ContentType = uploadSpec.ContentType
+			}
+			if appFlag.StorageClass == "" {
+				appFlag.StorageClass = uploadSpec.StorageClass
+			}
+			if len(appFlag.Grants) == 0 {
+				appFlag.Grants = uploadSpec.Grants
+			}
+		}
+	}
+
+	if appFlag.SplitParts > 1 && strings.EqualFold(appFlag.ActionType, Upload) {
+		if appFlag.CreateOnly || appFlag.IfGenMatch > 0 || appFlag.ExtraChecks {
+			fatal(ErrCategoryUnknown, "Split-parts parameter cannot be used together with create-only, if-generation-match or extra parameters!")
+		}
+		if appFlag.RequirePrivate || appFlag.RequireLocation != "" || appFlag.RequireClass != "" || appFlag.FailOnOverwrite || appFlag.SkipUnchanged {
+			fatal(ErrCategoryUnknown, "Split-parts parameter cannot be used together with require-private, require-location, require-class, fail-on-overwrite or skip-if-unchanged parameters!")
+		}
+		if len(appFlag.Grants) > 0 || appFlag.MakePrivate || appFlag.EventHold {
+			fatal(ErrCategoryUnknown, "Split-parts parameter cannot be used together with grant, make-private or event-hold parameters!")
+		}
+	}
+
+	if appFlag.GitMeta {
+		if !strings.EqualFold(appFlag.ActionType, Upload) {
+			LogWarn.Println("WARNING: Git-meta parameter is unnessary and discarded when action is not upload!")
+		} else {
+			gitMetadata = readGitMetadata()
+		}
+	}
+
+	if appFlag.ContentLanguage != "" {
+		err := validateLanguageTag(appFlag.ContentLanguage)
+		if err != nil {
+			fatal(classifyError(err), "Wrong content-language parameter specified! ("+errorDetail(err)+")")
+		}
+	}
+
+	if appFlag.StrictType && appFlag.ContentType != "" {
+		err := validateMediaType(appFlag.ContentType)
+		if err != nil {
+			fatal(classifyError(err), "Wrong type parameter specified! ("+errorDetail(err)+")")
+		}
+	}
+
+	if appFlag.ContentAddressed && strings.EqualFold(appFlag.ActionType, Upload) && uploadsMultipleFiles(appFlag.FilePaths) {
+		if appFlag.ObjectPath != "" {
+			LogWarn.Println("WARNING: Object parameter is unnessary and discarded when content-addressed is set!")
+		}
+		appFlag.ObjectPath = appFlag.Prefix
+	} else if appFlag.ContentAddressed && strings.EqualFold(appFlag.ActionType, Upload) {
+		if appFlag.ObjectPath != "" {
+			LogWarn.Println("WARNING: Object parameter is unnessary and discarded when content-addressed is set!")
+		}
+		sum, err := fileSHA256(appFlag.FilePath)
+		if err != nil {
+			fatal(classifyError(err), "Cannot compute SHA-256 of requested file! ("+errorDetail(err)+")")
+		}
+		appFlag.ObjectPath = joinObjectPrefix(appFlag.Prefix, sum)
+		LogInfo.Println("INFO: Content-addressed object name computed. (Object Path: " + appFlag.ObjectPath + ")")
+	} else if appFlag.ContentAddressed {
+		LogWarn.Println("WARNING: Content-addressed parameter is unnessary and discarded when action is not upload!")
+	} else if appFlag.Prefix != "" && strings.EqualFold(appFlag.ActionType, Upload) {
+		appFlag.ObjectPath = joinObjectPrefix(appFlag.Prefix, appFlag.ObjectPath)
+		LogDebug.Println("DEBUG: Prefix applied to object name. (Object Path: " + appFlag.ObjectPath + ")")
+	}
+
+	if appFlag.NormalizePath && appFlag.ObjectPath != "" {
+		normalizedPath, err := normalizeObjectPath(appFlag.ObjectPath)
+		if err != nil {
+			fatal(classifyError(err), "Cannot normalize object path! ("+errorDetail(err)+")")
+		}
+		if normalizedPath == "" {
+			fatal(ErrCategoryUnknown, "Object path is empty after normalization!")
+		}
+		LogInfo.Println("INFO: Object path normalized. (Object Path: " + normalizedPath + ")")
+		appFlag.ObjectPath = normalizedPath
+	}
+	if appFlag.NormalizePath && appFlag.DestObjectPath != "" {
+		normalizedPath, err := normalizeObjectPath(appFlag.DestObjectPath)
+		if err != nil {
+			fatal(classifyError(err), "Cannot normalize destination object path! ("+errorDetail(err)+")")
+		}
+		if normalizedPath == "" {
+			fatal(ErrCategoryUnknown, "Destination object path is empty after normalization!")
+		}
+		LogInfo.Println("INFO: Destination object path normalized. (Dest Object Path: " + normalizedPath + ")")
+		appFlag.DestObjectPath = normalizedPath
+	}
+	for _, retryCode := range splitList(appFlag.RetryOnCodes) {
+		code, err := strconv.Atoi(retryCode)
+		if err != nil || code < 100 || code > 599 {
+			fatal(ErrCategoryUnknown, "Wrong retry-on-codes parameter specified! (Code: "+retryCode+")")
+		}
+		appFlag.RetryCodes = append(appFlag.RetryCodes, code)
+	}
+	if len(appFlag.RetryCodes) > 0 && appFlag.MaxRetries == 0 && appFlag.PreflightRetries == 0 {
+		LogWarn.Println("WARNING: Retry-on-codes parameter is unnessary and discarded when max-retries and preflight-retries are not set!")
+	}
+	if appFlag.DoubleVerify && !strings.EqualFold(appFlag.ActionType, Upload) && !strings.EqualFold(appFlag.ActionType, Download) {
+		LogWarn.Println("WARNING: Double-verify parameter is unnessary and discarded when action is not upload or download!")
+	}
+	if appFlag.DoubleVerify && strings.EqualFold(appFlag.ActionType, Upload) && slices.Contains(appFlag.FilePaths, StdioPath) {
+		fatal(ErrCategoryUnknown, "Double-verify parameter cannot be used when uploading from stdin!")
+	}
+	if appFlag.DoubleVerify && strings.EqualFold(appFlag.ActionType, Upload) && appFlag.SplitParts > 1 {
+		LogWarn.Println("WARNING: Double-verify parameter is unnessary and discarded when split-parts is set, composed objects have no MD5!")
+	}
+	if appFlag.DoubleVerify && strings.EqualFold(appFlag.ActionType, Download) && appFlag.ResumeDownload {
+		fatal(ErrCategoryUnknown, "Double-verify and resume parameters cannot be used together!")
+	}
+	if rangeRequested() {
+		if !strings.EqualFold(appFlag.ActionType, Download) {
+			LogWarn.Println("WARNING: Range-start and range-length parameters are unnessary and discarded when action is not download!")
+		} else if appFlag.ResumeDownload || appFlag.DoubleVerify || appFlag.Head || appFlag.Base64Output {
+			fatal(ErrCategoryUnknown, "Range-start and range-length parameters cannot be used together with resume, double-verify, head or base64 parameters!")
+		}
+		if appFlag.RangeStart < 0 && appFlag.RangeLength >= 0 {
+			fatal(ErrCategoryUnknown, "Range-length parameter cannot be used when range-start is negative!")
+		}
+	}
+	if appFlag.IdleConnTimeout < 0 || appFlag.KeepAlive < 0 {
+		fatal(ErrCategoryUnknown, "Idle-conn-timeout and keep-alive parameters must be positive durations!")
+	}
+	if (appFlag.IdleConnTimeout != 0 || appFlag.KeepAlive != 0) && appFlag.UseGRPC {
+		LogWarn.Println("WARNING: Idle-conn-timeout and keep-alive parameters are unnessary and discarded when grpc is set, unless it falls back to HTTP!")
+	}
+	if appFlag.RateLimit > 0 {
+		if appFlag.RateLimit < minRateLimit {
+			fatal(ErrCategoryUnknown, "Rate-limit parameter cannot be less than "+strconv.Itoa(minRateLimit)+" bytes per second!")
+		}
+		rateLimiter.bytesPerSecond = float64(appFlag.RateLimit)
+		watchRateSignals()
+	}
+	if appFlag.Flatten && !strings.EqualFold(appFlag.ActionType, Upload) {
+		LogWarn.Println("WARNING: Flatten parameter is unnessary and discarded when action is not upload!")
+	}
+	if appFlag.DedupeSuffix && !strings.EqualFold(appFlag.ActionType, Upload) {
+		LogWarn.Println("WARNING: Dedupe-suffix parameter is unnessary and discarded when action is not upload!")
+	}
+	if appFlag.Gzip && !strings.EqualFold(appFlag.ActionType, Upload) {
+		LogWarn.Println("WARNING: Gzip parameter is unnessary and discarded when action is not upload!")
+		appFlag.Gzip = false
+	}
+	if appFlag.Gzip && (appFlag.DoubleVerify || appFlag.SkipUnchanged || appFlag.SplitParts > 1) {
+		fatal(ErrCategoryUnknown, "Gzip parameter cannot be used together with double-verify, skip-if-unchanged or split-parts parameters!")
+	}
+	if appFlag.VerifyRoundTrip && !strings.EqualFold(appFlag.ActionType, Upload) {
+		LogWarn.Println("WARNING: Verify-roundtrip parameter is unnessary and discarded when action is not upload!")
+		appFlag.VerifyRoundTrip = false
+	}
+	if appFlag.VerifyRoundTrip && slices.Contains(appFlag.FilePaths, StdioPath) {
+		fatal(ErrCategoryUnknown, "Verify-roundtrip parameter cannot be used when uploading from stdin!")
+	}
+	if appFlag.Verbose && !strings.EqualFold(appFlag.ActionType, Exists) {
+		LogWarn.Println("WARNING: Verbose parameter is unnessary and discarded when action is not exists!")
+	}
+	if appFlag.ReadBufferSize > 0 && !strings.EqualFold(appFlag.ActionType, Download) && !strings.EqualFold(appFlag.ActionType, DlVers) {
+		LogWarn.Println("WARNING: Read-buffer-size parameter is unnessary and discarded when action is not download!")
+	}
+	if appFlag.TmpDir != "" {
+		if !strings.EqualFold(appFlag.ActionType, Download) {
+			LogWarn.Println("WARNING: Tmp-dir parameter is unnessary and discarded when action is not download!")
+			appFlag.TmpDir = ""
+		} else if info, err := os.Stat(appFlag.TmpDir); err != nil || !info.IsDir() {
+			fatal(ErrCategoryUnknown, "Tmp-dir parameter must be an existing directory!")
+		}
+	}
+	if appFlag.IndexPath != "" {
+		if !appFlag.SkipUnchanged {
+			LogWarn.Println("WARNING: Index-file parameter is unnessary and discarded when skip-if-unchanged is not set!")
+			appFlag.IndexPath = ""
+		} else {
+			readLocalIndex(appFlag.IndexPath)
+		}
+	}
+	if appFlag.ManifestObject != "" {
+		if !strings.EqualFold(appFlag.ActionType, Upload) {
+			LogWarn.Println("WARNING: Write-manifest parameter is unnessary and discarded when action is not upload!")
+		}
+		err := validateObjectName(appFlag.ManifestObject)
+		if err != nil {
+			fatal(classifyError(err), "Wrong write-manifest parameter specified! ("+errorDetail(err)+")")
+		}
+	}
+	if appFlag.ObjectPath != "" {
+		err := validateObjectName(appFlag.ObjectPath)
+		if err != nil {
+			fatal(classifyError(err), "Wrong object parameter specified! ("+errorDetail(err)+")")
+		}
+	}
+	if appFlag.DestObjectPath != "" {
+		err := validateObjectName(appFlag.DestObjectPath)
+		if err != nil {
+			fatal(classifyError(err), "Wrong dest-object parameter specified! ("+errorDetail(err)+")")
+		}
+	}
+	if strings.EqualFold(appFlag.ActionType, Rename) && appFlag.ObjectPath == appFlag.DestObjectPath {
+		fatal(ErrCategoryUnknown, "Object and dest-object parameters cannot be identical when action is rename!")
+	}
+
+	if appFlag.RetryAction > 0 && !isActionAttempt() {
+		if !strings.EqualFold(appFlag.ActionType, Upload) && !strings.EqualFold(appFlag.ActionType, Download) {
+			LogWarn.Println("WARNING: Retry-action parameter is unnessary and discarded when action is not upload or download!")
+		} else if appFlag.FilePath == StdioPath {
+			fatal(ErrCategoryUnknown, "Retry-action parameter cannot be used when uploading from stdin!")
+		} else {
+			runActionWithRetries(int(appFlag.RetryAction))
+		}
+	}
+
+	if offlineVerify() {
+		LogDebug.Println("DEBUG: Expected hash is verified offline, credentials are not used.")
+		appFlag.PublicRequest = true
+	}
+
+	if appFlag.RecordPath != "" {
+		openRecordFile(appFlag.RecordPath)
+	}
 
 	storageUnderlyingDataObject := new(storageUnderlyingDataStruct)
 	storageUnderlyingDataObject.ctx, storageUnderlyingDataObject.cancel = createContext(int(appFlag.TimeoutValue))
 	storageUnderlyingDataObject.client = createClient(storageUnderlyingDataObject.ctx, appFlag.PublicRequest, appFlag.KeyPath)
 
-	if strings.EqualFold(appFlag.ActionType, Upload) {
+	if strings.EqualFold(appFlag.ActionType, Upload) && uploadsMultipleFiles(appFlag.FilePaths) {
+		uploadFiles(storageUnderlyingDataObject, appFlag.FilePaths, appFlag.BucketName, appFlag.ObjectPath, appFlag.ContentType)
+	} else if strings.EqualFold(appFlag.ActionType, Upload) && appFlag.SplitParts > 1 {
+		uploadFileComposite(storageUnderlyingDataObject, appFlag.FilePath, appFlag.BucketName, appFlag.ObjectPath, appFlag.ContentType)
+	} else if strings.EqualFold(appFlag.ActionType, Upload) {
 		uploadFile(storageUnderlyingDataObject, appFlag.FilePath, appFlag.BucketName, appFlag.ObjectPath, appFlag.ContentType)
+	} else if strings.EqualFold(appFlag.ActionType, Download) && appFlag.Base64Output {
+		downloadBase64(storageUnderlyingDataObject, appFlag.BucketName, appFlag.ObjectPath)
+	} else if strings.EqualFold(appFlag.ActionType, Download) && appFlag.Head {
+		statObject(storageUnderlyingDataObject, appFlag.BucketName, appFlag.ObjectPath)
 	} else if strings.EqualFold(appFlag.ActionType, Download) {
 		downloadFile(storageUnderlyingDataObject, appFlag.FilePath, appFlag.BucketName, appFlag.ObjectPath)
+	} else if strings.EqualFold(appFlag.ActionType, Delete) {
+		var objectPaths []string
+		if appFlag.ObjectPath != "" {
+			objectPaths = append(objectPaths, appFlag.ObjectPath)
+		}
+		if appFlag.ObjectListPath != "" {
+			objectPaths = append(objectPaths, readObjectList(appFlag.ObjectListPath)...)
+		}
+		deleteObjects(storageUnderlyingDataObject, appFlag.BucketName, objectPaths)
+	} else if strings.EqualFold(appFlag.ActionType, MakeBkt) {
+		createBucket(storageUnderlyingDataObject, appFlag.BucketName, appFlag.ProjectID)
+	} else if strings.EqualFold(appFlag.ActionType, SignPol) {
+		signUploadPolicy(storageUnderlyingDataObject, appFlag.BucketName, appFlag.ObjectPath, appFlag.ContentType)
+	} else if strings.EqualFold(appFlag.ActionType, Stat) {
+		statObject(storageUnderlyingDataObject, appFlag.BucketName, appFlag.ObjectPath)
+	} else if strings.EqualFold(appFlag.ActionType, Ping) {
+		pingBucket(storageUnderlyingDataObject, appFlag.BucketName)
+	} else if strings.EqualFold(appFlag.ActionType, List) {
+		listObjects(storageUnderlyingDataObject, appFlag.BucketName, appFlag.Prefix)
+	} else if strings.EqualFold(appFlag.ActionType, DlVers) {
+		downloadVersions(storageUnderlyingDataObject, appFlag.FilePath, appFlag.BucketName, appFlag.ObjectPath)
+	} else if strings.EqualFold(appFlag.ActionType, Update) {
+		updateObject(storageUnderlyingDataObject, appFlag.BucketName, appFlag.ObjectPath)
+	} else if strings.EqualFold(appFlag.ActionType, Verify) {
+		verifyFile(storageUnderlyingDataObject, appFlag.FilePath, appFlag.BucketName, appFlag.ObjectPath)
+	} else if strings.EqualFold(appFlag.ActionType, Exists) {
+		checkObjectExists(storageUnderlyingDataObject, appFlag.BucketName, appFlag.ObjectPath)
+	} else if strings.EqualFold(appFlag.ActionType, WhoAmI) {
+		printIdentity(storageUnderlyingDataObject)
+	} else if strings.EqualFold(appFlag.ActionType, RwClass) {
+		rewriteObjectClass(storageUnderlyingDataObject, appFlag.BucketName, appFlag.ObjectPath, appFlag.StorageClass)
+	} else if strings.EqualFold(appFlag.ActionType, Compare) {
+		compareDirectory(storageUnderlyingDataObject, appFlag.FilePath, appFlag.BucketName, appFlag.Prefix)
+	} else if strings.EqualFold(appFlag.ActionType, Rename) {
+		renameObject(storageUnderlyingDataObject, appFlag.BucketName, appFlag.ObjectPath, appFlag.DestObjectPath)
 	} else {
-		LogErr.Fatalln("FATAL ERROR: Wrong action parameter specified!")
+		fatal(ErrCategoryUnknown, "Wrong action parameter specified!")
+	}
+
+	if appFlag.ManifestObject != "" && strings.EqualFold(appFlag.ActionType, Upload) {
+		manifestUnderlyingDataObject := new(storageUnderlyingDataStruct)
+		manifestUnderlyingDataObject.ctx, manifestUnderlyingDataObject.cancel = createContext(int(appFlag.TimeoutValue))
+		manifestUnderlyingDataObject.client = createClient(manifestUnderlyingDataObject.ctx, appFlag.PublicRequest, appFlag.KeyPath)
+		writeManifest(manifestUnderlyingDataObject, appFlag.BucketName, appFlag.ManifestObject)
 	}
 
+	if appFlag.IndexPath != "" {
+		writeLocalIndex(appFlag.IndexPath)
+	}
+
+	finishRun(ExitStatusSuccess, true)
+
 	duration := fmt.Sprintf("%.1f", time.Since(start).Seconds())
 	LogAlways.Println("BYE MSG: All done in " + duration + "s, bye!")
 
+	if appFlag.ExitMessage {
+		printExitStatus(ExitStatusSuccess)
+	}
+
 }
 
 func createContext(timeoutValue int) (context.Context, context.CancelFunc) {
 
-	var timeoutDuration time.Duration
+	ctx := context.Background()
 	if timeoutValue <= 0 {
-		timeoutDuration = time.Second * 60
-	} else {
-		timeoutDuration = time.Second * time.Duration(timeoutValue)
+		return context.WithCancel(ctx)
 	}
 
-	ctx := context.Background()
+	timeoutDuration := time.Second * time.Duration(timeoutValue)
 	ctx, cancel := context.WithTimeout(ctx, timeoutDuration)
 
 	return ctx, cancel
 
 }
 
-func createClient(ctx context.Context, PublicRequest bool, keyPath string) *storage.Client {
+func createAuthOption(PublicRequest bool, keyPath string) option.ClientOption {
 
-	var clientOption option.ClientOption
 	if PublicRequest {
-		clientOption = option.WithoutAuthentication()
-	} else {
-		clientOption = option.WithCredentialsFile(keyPath)
+		return option.WithoutAuthentication()
+	} else if appFlag.AccessToken != "" {
+		return option.WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: appFlag.AccessToken}))
+	} else if keyPath == "" {
+		return option.WithScopes(storage.ScopeFullControl)
 	}
 
-	client, err := storage.NewClient(ctx, clientOption)
-	if err != nil {
-		LogErr.Fatalln("FATAL ERROR: Cannot create new storage client! (" + err.Error() + ")")
+	return option.WithCredentialsFile(keyPath)
+
+}
+
+func createClientOptions(ctx context.Context, PublicRequest bool, keyPath string) []option.ClientOption {
+
+	clientOption := createAuthOption(PublicRequest, keyPath)
+
+	clientOptions := []option.ClientOption{clientOption}
+	if strings.EqualFold(appFlag.LogLevel, "debug") || appFlag.MaxRetries > 0 || appFlag.TLSMinVersion != "" || appFlag.CACertPath != "" || appFlag.IdleConnTimeout != 0 || appFlag.KeepAlive != 0 {
+		clientOptions = []option.ClientOption{option.WithHTTPClient(createHTTPClient(ctx, clientOption))}
+	}
+
+	return clientOptions
+
+}
+
+func createClient(ctx context.Context, PublicRequest bool, keyPath string) *storage.Client {
+
+	var client *storage.Client
+	var err error
+	if appFlag.UseGRPC {
+		client, err = storage.NewGRPCClient(ctx, createAuthOption(PublicRequest, keyPath))
+		if err != nil {
+			LogWarn.Println("WARNING: Cannot create gRPC storage client, going to fall back to HTTP! (" + errorDetail(err) + ")")
+		} else {
+			LogDebug.Println("DEBUG: gRPC storage client created.")
+		}
+	}
+
+	if client == nil {
+		client, err = storage.NewClient(ctx, createClientOptions(ctx, PublicRequest, keyPath)...)
+		if err != nil {
+			fatal(classifyError(err), "Cannot create new storage client! ("+errorDetail(err)+")")
+		}
+	}
+
+	if appFlag.MaxRetries > 0 {
+		client.SetRetry(storage.WithErrorFunc(retryErrorFunc(int64(appFlag.MaxRetries), &retryCount)))
 	}
 
 	return client
 
 }
 
+func chunkSizeForDeclaredSize(size int64) int {
+
+	if size <= googleapi.DefaultUploadChunkSize {
+		return 0
+	}
+
+	chunkSize := size / maxUploadChunks
+	if chunkSize < googleapi.DefaultUploadChunkSize {
+		chunkSize = googleapi.DefaultUploadChunkSize
+	} else if chunkSize > maxUploadChunkSize {
+		chunkSize = maxUploadChunkSize
+	}
+
+	return int(chunkSize)
+
+}
+
+type uploadResultStruct struct {
+	status      string
+	objectPath  string
+	bytes       int64
+	overwritten bool
+}
+
+func skipUnchangedFile(ctx context.Context, obj *storage.ObjectHandle, file *os.File) (bool, error) {
+
+	objAttrs, err := cachedObjectAttrs(ctx, obj)
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			return false, nil
+		}
+		return false, newCategorizedError(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
+	}
+
+	crc, err := indexedFileCRC32C(file)
+	if err != nil {
+		return false, newCategorizedError(classifyError(err), "Cannot compute checksum of requested file! ("+errorDetail(err)+")")
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return false, newCategorizedError(classifyError(err), "Cannot seek requested file! ("+errorDetail(err)+")")
+	}
+
+	if crc != objAttrs.CRC32C {
+		return false, nil
+	}
+
+	LogInfo.Println("SKIPPED: Object is unchanged, upload skipped. (Existing Object's CRC32: " + formatCRC32C(objAttrs.CRC32C) + ", GENERATION: " + strconv.FormatInt(objAttrs.Generation, 10) + ")")
+	recordManifestEntry(objAttrs)
+	recordObject(objAttrs.Name, RecordSkipped, 0, formatCRC32C(objAttrs.CRC32C), 0, nil)
+
+	return true, nil
+
+}
+
 func uploadFile(storageUnderlyingDataObject *storageUnderlyingDataStruct, filePath string, bucketName string, objectPath string, contentType string) {
 
 	ctx := storageUnderlyingDataObject.ctx
@@ -168,66 +1231,399 @@ func uploadFile(storageUnderlyingDataObject *storageUnderlyingDataStruct, filePa
 
 	defer cancel()
 	defer client.Close()
+	defer trackRecordObject(objectPath)()
 
-	file, err := os.Open(filePath)
+	result, err := uploadObject(ctx, client, filePath, bucketName, objectPath, contentType)
+	if err == errAlreadyExists {
+		exitAlreadyExists()
+	}
+	if errors.Is(err, errMaxTotalBytes) {
+		fatal(classifyError(err), "Max-total-bytes limit exceeded, upload aborted! (Object: "+result.objectPath+", Max Total Bytes: "+strconv.FormatUint(appFlag.MaxTotalBytes, 10)+")")
+	}
 	if err != nil {
-		LogErr.Fatalln("FATAL ERROR: Cannot open requested file! (" + err.Error() + ")")
+		fatal(classifyError(err), err.Error())
+	}
+
+	if appFlag.FailOnOverwrite && result.overwritten {
+		exitOverwritten()
+	}
+
+}
+
+func uploadObject(ctx context.Context, client *storage.Client, filePath string, bucketName string, objectPath string, contentType string) (uploadResultStruct, error) {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	uploadStart := time.Now()
+	result := uploadResultStruct{status: RecordUploaded, objectPath: objectPath}
+
+	var file *os.File
+	var err error
+	if filePath == StdioPath {
+		file = os.Stdin
+	} else {
+		file, err = os.Open(filePath)
+		if err != nil {
+			return result, newCategorizedError(classifyError(err), "Cannot open requested file! ("+errorDetail(err)+")")
+		}
+		defer file.Close()
+	}
+
+	if len(appFlag.AllowTypes) > 0 {
+		err = checkAllowedType(file, filePath)
+		if err != nil {
+			return result, err
+		}
 	}
-	defer file.Close()
 
 	bkt := client.Bucket(bucketName)
 	obj := bkt.Object(objectPath)
 
+	if appFlag.SkipUnchanged && filePath != StdioPath {
+		skip, err := skipUnchangedFile(ctx, obj, file)
+		if err != nil {
+			return result, err
+		}
+		if skip {
+			result.status = RecordSkipped
+			return result, nil
+		}
+	}
+
+	onConflict := !strings.EqualFold(appFlag.OnConflict, ConflictOverwrite)
+	if strings.EqualFold(appFlag.OnConflict, ConflictSkip) {
+		skip, err := objectExistsOnConflict(ctx, obj)
+		if err != nil {
+			return result, err
+		}
+		if skip {
+			skipOnConflict(obj)
+			result.status = RecordSkipped
+			return result, nil
+		}
+	}
+
 	if appFlag.ExtraChecks {
-		_, err = bkt.Attrs(ctx)
+		bktAttrs, err := cachedBucketAttrs(ctx, bkt)
 		if err != nil {
 			if err == storage.ErrBucketNotExist {
-				LogErr.Fatalln("FATAL ERROR: Bucket does not exist!")
-			} else {
-				LogErr.Fatalln("FATAL ERROR: Cannot fetch bucket info! (" + err.Error() + ")")
+				return result, newCategorizedError(classifyError(err), "Bucket does not exist! ("+errorDetail(err)+")")
 			}
+			return result, newCategorizedError(classifyError(err), "Cannot fetch bucket info! ("+errorDetail(err)+")")
+		}
+		err = checkBucketPlacement(bktAttrs)
+		if err == nil {
+			err = checkBucketAutoclass(bktAttrs)
+		}
+		if err == nil && appFlag.RequirePrivate {
+			err = checkBucketPrivate(bktAttrs)
+		}
+		if err != nil {
+			return result, err
 		}
 
-		objAttrs, err := obj.Attrs(ctx)
+		objAttrs, err := cachedObjectAttrs(ctx, obj)
 		if err != nil {
-			if err == storage.ErrObjectNotExist {
-				LogWarn.Println("WARNING: Object does not exist, going to create a new one.")
-			} else {
-				LogErr.Fatalln("FATAL ERROR: Cannot fetch object info! (" + err.Error() + ")")
+			if err != storage.ErrObjectNotExist {
+				return result, newCategorizedError(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
 			}
+			LogWarn.Println("WARNING: Object does not exist, going to create a new one.")
+		} else if appFlag.CreateOnly {
+			return result, errAlreadyExists
+		} else if onConflict {
+			LogInfo.Println("INFO: Object exists, going to upload under a suffixed name on conflict. (Existing Object's GENERATION: " + strconv.FormatInt(objAttrs.Generation, 10) + ")")
 		} else {
-			LogWarn.Println("WARNING: Object exists, going to override it! (Existing Object's SIZE: " + strconv.FormatInt(objAttrs.Size, 10) + ", CRC32: " + strconv.FormatUint(uint64(objAttrs.CRC32C), 10) + ", GENERATION: " + strconv.FormatInt(objAttrs.Generation, 10) + ")")
+			if appFlag.Diff && filePath != StdioPath {
+				identical, err := diffObject(ctx, obj, objAttrs, file)
+				if err != nil {
+					return result, err
+				}
+				if identical {
+					LogInfo.Println("INFO: Local file is identical to existing object, upload skipped. (Object: " + objectPath + ")")
+					result.status = RecordSkipped
+					return result, nil
+				}
+			}
+			if !appFlag.AssumeYes && filePath != StdioPath && isTerminal(os.Stdin) && !promptConfirm("Object exists, overwrite? (Object: "+objectPath+")") {
+				return result, newCategorizedError(ErrCategoryUnknown, "Overwriting object aborted by user!")
+			}
+			LogWarn.Println("WARNING: Object exists, going to override it! (Existing Object's SIZE: " + strconv.FormatInt(objAttrs.Size, 10) + ", CRC32: " + formatCRC32C(objAttrs.CRC32C) + ", GENERATION: " + strconv.FormatInt(objAttrs.Generation, 10) + ")")
+			result.overwritten = true
+		}
+	} else if appFlag.FailOnOverwrite && !onConflict {
+		_, err := cachedObjectAttrs(ctx, obj)
+		if err == nil {
+			result.overwritten = true
+		} else if err != storage.ErrObjectNotExist {
+			return result, newCategorizedError(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
 		}
 	}
 
-	writer := obj.NewWriter(ctx)
-	defer writer.Close()
+	objectGrants := parseObjectGrants(appFlag.Grants)
+	if len(objectGrants) > 0 || appFlag.MakePrivate {
+		err = checkObjectACLAllowed(ctx, bkt)
+		if err != nil {
+			return result, err
+		}
+	}
 
-	if appFlag.ContentType != "" {
-		writer.ContentType = contentType
+	var objectMeta objectMetaStruct
+	var hasObjectMeta bool
+	if appFlag.ReadMeta && filePath != StdioPath {
+		objectMeta, hasObjectMeta, err = readMetaSidecar(filePath)
+		if err != nil {
+			return result, err
+		}
 	}
 
-	bytes, err := io.Copy(writer, file)
-	if err != nil {
-		LogErr.Fatalln("FATAL ERROR: Cannot copy file to bucket! (" + err.Error() + ")")
+	declaredSize := filePath == StdioPath && appFlag.DeclaredSize > 0
+
+	var localCRC uint32
+	var localMD5 []byte
+	if appFlag.DoubleVerify {
+		localCRC, localMD5, err = fileHashes(file)
+		if err != nil {
+			return result, newCategorizedError(classifyError(err), "Cannot compute checksums of requested file! ("+errorDetail(err)+")")
+		}
+	}
+
+	var writer *storage.Writer
+	var bytes int64
+	var transferStart time.Time
+	for conflictSuffix := 1; ; conflictSuffix++ {
+		writerObj := obj
+		if appFlag.CreateOnly || onConflict {
+			writerObj = obj.If(storage.Conditions{DoesNotExist: true})
+		} else if appFlag.IfGenMatch > 0 {
+			writerObj = obj.If(storage.Conditions{GenerationMatch: appFlag.IfGenMatch})
+		}
+		if appFlag.UnsafeRetry {
+			writerObj = writerObj.Retryer(storage.WithPolicy(storage.RetryAlways))
+		}
+
+		writer = writerObj.NewWriter(ctx)
+
+		if hasObjectMeta {
+			writer.ContentType = objectMeta.ContentType
+			writer.CacheControl = objectMeta.CacheControl
+			writer.ContentEncoding = objectMeta.ContentEncoding
+			writer.Metadata = objectMeta.Metadata
+		}
+
+		if uploadSpec.CacheControl != "" {
+			writer.CacheControl = uploadSpec.CacheControl
+		}
+		if uploadSpec.ContentEncoding != "" {
+			writer.ContentEncoding = uploadSpec.ContentEncoding
+		}
+
+		if appFlag.Gzip {
+			writer.ContentEncoding = "gzip"
+		}
+
+		if appFlag.ContentType != "" {
+			writer.ContentType = contentType
+		}
+		if appFlag.StorageClass != "" {
+			writer.StorageClass = strings.ToUpper(appFlag.StorageClass)
+		}
+		if appFlag.ContentLanguage != "" {
+			writer.ContentLanguage = appFlag.ContentLanguage
+		}
+
+		metadata := objectMetadata()
+		if writer.Metadata == nil {
+			writer.Metadata = metadata
+		} else {
+			for key, value := range metadata {
+				writer.Metadata[key] = value
+			}
+		}
+
+		if appFlag.NoBuffer {
+			writer.ChunkSize = 0
+		} else if declaredSize {
+			writer.ChunkSize = chunkSizeForDeclaredSize(int64(appFlag.DeclaredSize))
+		}
+
+		if appFlag.Progress {
+			totalSize := int64(-1)
+			if !appFlag.Gzip {
+				if declaredSize {
+					totalSize = int64(appFlag.DeclaredSize)
+				} else if info, err := file.Stat(); err == nil && info.Mode().IsRegular() {
+					totalSize = info.Size()
+				}
+			}
+			reporter := newProgressReporter("Bytes committed to GCP Bucket.", totalSize)
+			writer.ProgressFunc = reporter.report
+		}
+
+		if appFlag.DoubleVerify {
+			writer.CRC32C = localCRC
+			writer.SendCRC32C = true
+			writer.MD5 = localMD5
+		}
+
+		var source io.Reader = file
+		var crcHash hash.Hash32
+		var md5Hash hash.Hash
+		if appFlag.PrintLocalHash {
+			crcHash = crc32.New(crc32cTable)
+			md5Hash = md5.New()
+			source = io.TeeReader(file, io.MultiWriter(crcHash, md5Hash))
+		}
+
+		var destination io.Writer = writer
+		var gzipWriter *gzip.Writer
+		if appFlag.Gzip {
+			gzipWriter = gzip.NewWriter(writer)
+			destination = gzipWriter
+		}
+
+		transferStart = time.Now()
+		bytes, err = copyBuffered(destination, transferReader(ctx, source))
+		if err == nil && gzipWriter != nil {
+			err = gzipWriter.Close()
+		}
+		if errors.Is(err, errMaxTotalBytes) {
+			return result, err
+		}
+		copyFailed := err != nil
+
+		if !copyFailed {
+			if appFlag.PrintLocalHash {
+				LogInfo.Println("INFO: Local file hashed. (Local File's CRC32: " + formatCRC32C(crcHash.Sum32()) + ", MD5: " + hex.EncodeToString(md5Hash.Sum(nil)) + ")")
+			}
+
+			if declaredSize && bytes != int64(appFlag.DeclaredSize) {
+				LogWarn.Println("WARNING: Written bytes do not match declared size! (Declared Size: " + strconv.FormatUint(appFlag.DeclaredSize, 10) + ", Written Bytes: " + strconv.FormatInt(bytes, 10) + ")")
+			}
+
+			err = writer.Close()
+		}
+		if err == nil {
+			break
+		}
+
+		if onConflict && isPreconditionFailed(err) {
+			if strings.EqualFold(appFlag.OnConflict, ConflictSkip) {
+				skipOnConflict(obj)
+				result.status = RecordSkipped
+				return result, nil
+			}
+			if filePath == StdioPath {
+				return result, newCategorizedError(classifyError(err), "Object exists and stdin cannot be read again, upload aborted on conflict! (Object: "+obj.ObjectName()+", "+errorDetail(err)+")")
+			}
+			if conflictSuffix > maxConflictSuffix {
+				return result, newCategorizedError(classifyError(err), "Cannot find a free object name on conflict! (Object: "+objectPath+", Tried Suffixes: "+strconv.Itoa(maxConflictSuffix)+")")
+			}
+
+			if copyFailed {
+				writer.Close()
+			}
+
+			_, err = file.Seek(0, io.SeekStart)
+			if err != nil {
+				return result, newCategorizedError(classifyError(err), "Cannot seek requested file! ("+errorDetail(err)+")")
+			}
+
+			LogDebug.Println("DEBUG: Object exists, trying next name on conflict. (Object: " + obj.ObjectName() + ")")
+			obj = bkt.Object(conflictObjectName(objectPath, conflictSuffix))
+			result.objectPath = obj.ObjectName()
+			continue
+		}
+
+		if appFlag.CreateOnly && isPreconditionFailed(err) {
+			return result, errAlreadyExists
+		}
+		if copyFailed {
+			return result, newCategorizedError(classifyError(err), "Cannot copy file to bucket! ("+errorDetail(err)+")")
+		}
+		return result, newCategorizedError(classifyError(err), "Cannot write file to bucket! ("+errorDetail(err)+")")
+	}
+
+	if obj.ObjectName() != objectPath {
+		LogInfo.Println("INFO: Object exists, upload renamed on conflict. (Object: " + obj.ObjectName() + ")")
+	}
+	transferElapsed := time.Since(transferStart)
+	result.bytes = bytes
+
+	if appFlag.EventHold {
+		objAttrsHeld, err := obj.Update(ctx, storage.ObjectAttrsToUpdate{EventBasedHold: true})
+		if err != nil {
+			return result, newCategorizedError(classifyError(err), "Cannot place event-based hold on object! ("+errorDetail(err)+")")
+		}
+
+		retentionExpiration := "N/A"
+		if !objAttrsHeld.RetentionExpirationTime.IsZero() {
+			retentionExpiration = objAttrsHeld.RetentionExpirationTime.UTC().Format(time.RFC3339)
+		}
+		LogInfo.Println("INFO: Event-based hold placed on object. (Retention Expiration: " + retentionExpiration + ")")
+	}
+
+	if appFlag.DoubleVerify {
+		err = verifyObjectHashes(ctx, obj.Generation(writer.Attrs().Generation), localCRC, localMD5)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	if appFlag.VerifyRoundTrip {
+		err = verifyRoundTrip(ctx, obj, writer.Attrs(), filePath)
+		if err != nil {
+			return result, err
+		}
 	}
 
-	err = writer.Close()
+	err = applyObjectGrants(ctx, obj, objectGrants)
 	if err != nil {
-		LogErr.Fatalln("FATAL ERROR: Cannot write file to bucket! (" + err.Error() + ")")
+		return result, err
 	}
 
-	if appFlag.ExtraChecks {
-		objAttrsNew, err := obj.Attrs(ctx)
+	if appFlag.MakePrivate {
+		err = removePublicAccess(ctx, obj)
 		if err != nil {
-			LogErr.Fatalln("FATAL ERROR: Cannot fetch object info! (" + err.Error() + ")")
+			return result, err
+		}
+	}
+
+	if appFlag.WebhookURL != "" {
+		notifyWebhook(appFlag.WebhookURL, writer.Attrs(), time.Since(uploadStart))
+	}
+
+	recordManifestEntry(writer.Attrs())
+
+	runMetrics.objectsUploaded.Add(1)
+	runMetrics.bytesTransferred.Add(bytes)
+	recordObject(obj.ObjectName(), RecordUploaded, bytes, formatCRC32C(writer.Attrs().CRC32C), time.Since(uploadStart), nil)
+
+	if appFlag.Throughput {
+		reportThroughput(bytes, transferElapsed)
+	}
+
+	if appFlag.ExtraChecks {
+		objAttrsNew := writer.Attrs()
+		if objAttrsNew == nil {
+			objAttrsNew, err = objectAttrs(ctx, obj)
+			if err != nil {
+				return result, newCategorizedError(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
+			}
+		}
+
+		language := ""
+		if objAttrsNew.ContentLanguage != "" {
+			language = ", LANGUAGE: " + objAttrsNew.ContentLanguage
 		}
 
-		LogInfo.Println("SUCCESS: Object uploaded to GCP Bucket. (Uploaded Object's SIZE: " + strconv.FormatInt(objAttrsNew.Size, 10) + ", CRC32: " + strconv.FormatUint(uint64(objAttrsNew.CRC32C), 10) + ", GENERATION: " + strconv.FormatInt(objAttrsNew.Generation, 10) + ")")
+		logSuccess("Object uploaded to GCP Bucket.", "Uploaded Object's SIZE: "+strconv.FormatInt(objAttrsNew.Size, 10)+", CRC32: "+formatCRC32C(objAttrsNew.CRC32C)+", GENERATION: "+strconv.FormatInt(objAttrsNew.Generation, 10)+language, objAttrsNew)
 	} else {
-		LogInfo.Println("SUCCESS: Object uploaded to GCP Bucket. (Written Bytes: " + strconv.FormatInt(bytes, 10) + ")")
+		logSuccess("Object uploaded to GCP Bucket.", "Written Bytes: "+strconv.FormatInt(bytes, 10), writer.Attrs())
 	}
 
+	return result, nil
+
 }
 
 func downloadFile(storageUnderlyingDataObject *storageUnderlyingDataStruct, filePath string, bucketName string, objectPath string) {
@@ -238,63 +1634,328 @@ func downloadFile(storageUnderlyingDataObject *storageUnderlyingDataStruct, file
 
 	defer cancel()
 	defer client.Close()
+	defer trackRecordObject(objectPath)()
 
+	downloadStart := time.Now()
+
+	if appFlag.PreservePath {
+		filePath = preservedFilePath(filePath, objectPath)
+	}
+
+	bkt := client.Bucket(bucketName)
+	obj := bkt.Object(objectPath)
+
+	var rawService *raw.Service
+	var softDeletedObject *raw.Object
+	if appFlag.SoftDeleted {
+		rawService = createRawService(ctx)
+		softDeletedObject = findSoftDeletedObject(ctx, rawService, bucketName, objectPath, appFlag.Generation)
+	} else if appFlag.Generation > 0 {
+		obj = obj.Generation(appFlag.Generation)
+	}
+	obj = obj.ReadCompressed(appFlag.RawDownload)
+
+	sourceAttrs := func() (*storage.ObjectAttrs, error) {
+		if softDeletedObject != nil {
+			return softDeletedObjectAttrs(softDeletedObject), nil
+		}
+		return objectAttrs(ctx, obj)
+	}
+
+	directWrite := false
 	if info, err := os.Stat(filePath); err == nil {
 		if info.Mode().IsRegular() {
+			if appFlag.IfNewer {
+				objAttrs, err := sourceAttrs()
+				if err != nil {
+					fatal(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
+				}
+				if !info.ModTime().Before(objAttrs.Updated) {
+					LogInfo.Println("SKIPPED: Local file is up to date, download skipped. (Local File's MTIME: " + info.ModTime().UTC().Format(time.RFC3339) + ", Object's UPDATED: " + objAttrs.Updated.UTC().Format(time.RFC3339) + ")")
+					return
+				}
+			}
 			LogWarn.Println("WARNING: File exists, going to override it! (Existing File's SIZE: " + strconv.FormatInt(info.Size(), 10) + ")")
 		} else {
 			LogWarn.Println("WARNING: Path exists but not a regular file!")
+			directWrite = true
 		}
 
 	}
 
-	file, err := os.Create(filePath)
+	if appFlag.MaxSize > 0 {
+		objAttrs, err := sourceAttrs()
+		if err != nil {
+			fatal(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
+		}
+		if uint64(objAttrs.Size) > appFlag.MaxSize {
+			fatal(ErrCategoryUnknown, "Object size exceeds max-size limit, download aborted! (Object's SIZE: "+strconv.FormatInt(objAttrs.Size, 10)+", Max Size: "+strconv.FormatUint(appFlag.MaxSize, 10)+")")
+		}
+	}
+
+	var file *os.File
+	var err error
+	partPath := filePath + PartSuffix
+	if appFlag.TmpDir != "" {
+		partPath = filepath.Join(appFlag.TmpDir, filepath.Base(filePath)+PartSuffix)
+	}
+	if appFlag.ResumeDownload {
+		file, err = os.OpenFile(partPath, os.O_RDWR|os.O_CREATE, 0666)
+	} else if directWrite {
+		file, err = os.Create(filePath)
+	} else {
+		file, err = os.Create(partPath)
+	}
 	if err != nil {
-		LogErr.Fatalln("FATAL ERROR: Cannot create requested file! (" + err.Error() + ")")
+		fatal(classifyError(err), "Cannot create requested file! ("+errorDetail(err)+")")
 	}
 	defer file.Close()
 
-	bkt := client.Bucket(bucketName)
-	obj := bkt.Object(objectPath)
+	if softDeletedObject != nil {
+		restoreSoftDeletedObject(ctx, rawService, softDeletedObject)
+	}
 
 	if appFlag.ExtraChecks {
-		_, err = bkt.Attrs(ctx)
+		_, err = bucketAttrs(ctx, preflightBucket(bkt))
 		if err != nil {
 			if err == storage.ErrBucketNotExist {
-				LogErr.Fatalln("FATAL ERROR: Bucket does not exist!")
+				fatal(classifyError(err), "Bucket does not exist! ("+errorDetail(err)+")")
 			} else {
-				LogErr.Fatalln("FATAL ERROR: Cannot fetch bucket info! (" + err.Error() + ")")
+				fatal(classifyError(err), "Cannot fetch bucket info! ("+errorDetail(err)+")")
 			}
 		}
 
-		objAttrs, err := obj.Attrs(ctx)
+		objAttrs, err := objectAttrs(ctx, preflightObject(obj))
 		if err != nil {
 			if err == storage.ErrObjectNotExist {
-				LogErr.Fatalln("FATAL ERROR: Object does not exist!")
+				fatal(classifyError(err), "Object does not exist! ("+errorDetail(err)+")")
 			} else {
-				LogErr.Fatalln("FATAL ERROR: Cannot fetch object info! (" + err.Error() + ")")
+				fatal(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
 			}
 		} else {
-			LogWarn.Println("WARNING: Object exists! (Existing Object's SIZE: " + strconv.FormatInt(objAttrs.Size, 10) + ", CRC32: " + strconv.FormatUint(uint64(objAttrs.CRC32C), 10) + ", GENERATION: " + strconv.FormatInt(objAttrs.Generation, 10) + ")")
+			LogWarn.Println("WARNING: Object exists! (Existing Object's SIZE: " + strconv.FormatInt(objAttrs.Size, 10) + ", CRC32: " + formatCRC32C(objAttrs.CRC32C) + ", GENERATION: " + strconv.FormatInt(objAttrs.Generation, 10) + ")")
+		}
+	}
+
+	if appFlag.ResumeDownload {
+		bytes, crc := resumeDownload(ctx, obj, file)
+		runMetrics.objectsDownloaded.Add(1)
+		runMetrics.bytesTransferred.Add(bytes)
+		recordObject(objectPath, RecordDownloaded, bytes, formatCRC32C(crc), time.Since(downloadStart), nil)
+
+		file.Close()
+		err = moveFile(partPath, filePath)
+		if err != nil {
+			fatal(classifyError(err), "Cannot move partial file to requested file! ("+errorDetail(err)+")")
 		}
+
+		if appFlag.WriteMeta {
+			writeMetaSidecar(ctx, obj, filePath, false)
+		}
+
+		logSuccess("Object downloaded from GCP Bucket.", "Written Bytes: "+strconv.FormatInt(bytes, 10), fullDetailAttrs(ctx, obj))
+		return
 	}
 
-	reader, err := obj.NewReader(ctx)
+	var reader *storage.Reader
+	if rangeRequested() {
+		reader, err = openObjectReader(ctx, obj, appFlag.RangeStart, appFlag.RangeLength)
+	} else {
+		reader, err = openObjectReader(ctx, obj, 0, -1)
+	}
+	if rangeRequested() && isRangeNotSatisfiable(err) {
+		LogInfo.Println("INFO: Requested range starts at or past end of object, nothing served. (Start Offset: " + strconv.FormatInt(appFlag.RangeStart, 10) + ")")
+
+		file.Close()
+		if !directWrite {
+			err = moveFile(partPath, filePath)
+			if err != nil {
+				os.Remove(partPath)
+				fatal(classifyError(err), "Cannot move temporary file to requested file! ("+errorDetail(err)+")")
+			}
+		}
+
+		if appFlag.WriteMeta {
+			writeMetaSidecar(ctx, obj, filePath, false)
+		}
+
+		runMetrics.objectsDownloaded.Add(1)
+		recordObject(objectPath, RecordDownloaded, 0, formatCRC32C(0), time.Since(downloadStart), nil)
+
+		logSuccess("Object downloaded from GCP Bucket.", "Written Bytes: 0", fullDetailAttrs(ctx, obj))
+		return
+	}
 	if err != nil {
-		LogErr.Fatalln("FATAL ERROR: Cannot create new reader! (" + err.Error() + ")")
+		fatal(classifyError(err), "Cannot create new reader! ("+errorDetail(err)+")")
 	}
 	defer reader.Close()
 
-	bytes, err := io.Copy(file, reader)
+	var source io.Reader = reader
+	if appFlag.Progress {
+		progressTotal := reader.Attrs.Size
+		if rangeRequested() {
+			progressTotal = servedRangeBytes(reader.Attrs)
+		}
+		source = &progressReader{reader: reader, reporter: newProgressReporter("Bytes written to local file.", progressTotal)}
+	}
+
+	doubleVerify := appFlag.DoubleVerify
+	if doubleVerify && reader.Attrs.ContentEncoding == "gzip" && !appFlag.RawDownload {
+		LogWarn.Println("WARNING: Double verification is skipped for gzip encoded object, set raw as 'true' to verify stored bytes!")
+		doubleVerify = false
+	}
+	crcHash := crc32.New(crc32cTable)
+	md5Hash := md5.New()
+	if doubleVerify {
+		source = io.TeeReader(source, io.MultiWriter(crcHash, md5Hash))
+	} else if appFlag.RecordPath != "" {
+		source = io.TeeReader(source, crcHash)
+	}
+
+	bytes, err := copyDownload(file, transferReader(ctx, source))
 	if err != nil {
-		LogErr.Fatalln("FATAL ERROR: Cannot copy object from bucket! (" + err.Error() + ")")
+		fatal(classifyError(err), "Cannot copy object from bucket! ("+errorDetail(err)+")")
 	}
 
 	err = reader.Close()
 	if err != nil {
-		LogErr.Fatalln("FATAL ERROR: Cannot read object from bucket! (" + err.Error() + ")")
+		fatal(classifyError(err), "Cannot read object from bucket! ("+errorDetail(err)+")")
+	}
+
+	if doubleVerify {
+		err = verifyObjectHashes(ctx, obj.Generation(reader.Attrs.Generation), crcHash.Sum32(), md5Hash.Sum(nil))
+		if err != nil {
+			fatal(classifyError(err), err.Error())
+		}
+	}
+
+	if rangeRequested() {
+		logServedRange(reader.Attrs, bytes)
+	}
+
+	if appFlag.VerifySize || appFlag.ExtraChecks {
+		if reader.Attrs.ContentEncoding == "gzip" && !appFlag.RawDownload {
+			LogDebug.Println("DEBUG: Size verification skipped for gzip encoded object.")
+		} else if rangeRequested() {
+			if bytes != servedRangeBytes(reader.Attrs) {
+				fatal(ErrCategoryUnknown, "Downloaded bytes do not match served range! (Expected Bytes: "+strconv.FormatInt(servedRangeBytes(reader.Attrs), 10)+", Written Bytes: "+strconv.FormatInt(bytes, 10)+")")
+			}
+		} else if bytes != reader.Attrs.Size {
+			fatal(ErrCategoryUnknown, "Downloaded bytes do not match object size! (Object's SIZE: "+strconv.FormatInt(reader.Attrs.Size, 10)+", Written Bytes: "+strconv.FormatInt(bytes, 10)+")")
+		}
+	}
+
+	if !directWrite {
+		file.Close()
+		err = moveFile(partPath, filePath)
+		if err != nil {
+			os.Remove(partPath)
+			fatal(classifyError(err), "Cannot move temporary file to requested file! ("+errorDetail(err)+")")
+		}
+	}
+
+	if appFlag.WriteMeta {
+		writeMetaSidecar(ctx, obj, filePath, reader.Attrs.ContentEncoding == "gzip" && !appFlag.RawDownload)
+	}
+
+	runMetrics.objectsDownloaded.Add(1)
+	runMetrics.bytesTransferred.Add(bytes)
+	recordObject(objectPath, RecordDownloaded, bytes, formatCRC32C(crcHash.Sum32()), time.Since(downloadStart), nil)
+
+	logSuccess("Object downloaded from GCP Bucket.", "Written Bytes: "+strconv.FormatInt(bytes, 10), fullDetailAttrs(ctx, obj))
+
+}
+
+func preservedFilePath(dirPath string, objectPath string) string {
+
+	info, err := os.Stat(dirPath)
+	if err != nil || !info.IsDir() {
+		fatal(ErrCategoryUnknown, "File parameter must be an existing directory when preserve-path is set!")
+	}
+
+	relativePath, err := normalizeObjectPath(objectPath)
+	if err != nil {
+		fatal(classifyError(err), "Cannot preserve object path! ("+errorDetail(err)+")")
+	}
+	relativePath = filepath.FromSlash(relativePath)
+	if relativePath == "" || strings.HasSuffix(objectPath, "/") || !filepath.IsLocal(relativePath) {
+		fatal(ErrCategoryUnknown, "Object path cannot be preserved as a local file path!")
+	}
+
+	filePath := filepath.Join(dirPath, relativePath)
+
+	err = os.MkdirAll(filepath.Dir(filePath), 0755)
+	if err != nil {
+		fatal(classifyError(err), "Cannot create requested directory! ("+errorDetail(err)+")")
+	}
+
+	LogInfo.Println("INFO: Object path preserved under directory. (File Path: " + filePath + ")")
+
+	return filePath
+
+}
+
+func resumeDownload(ctx context.Context, obj *storage.ObjectHandle, file *os.File) (int64, uint32) {
+
+	objAttrs, err := objectAttrs(ctx, obj)
+	if err != nil {
+		fatal(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
+	}
+	if objAttrs.ContentEncoding == "gzip" {
+		fatal(ErrCategoryUnknown, "Resume is not supported for gzip encoded objects!")
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		fatal(classifyError(err), "Cannot stat partial file! ("+errorDetail(err)+")")
+	}
+
+	offset := info.Size()
+	if offset > objAttrs.Size {
+		LogWarn.Println("WARNING: Partial file is larger than object, going to restart download! (Existing Part's SIZE: " + strconv.FormatInt(offset, 10) + ")")
+		err = file.Truncate(0)
+		if err != nil {
+			fatal(classifyError(err), "Cannot truncate partial file! ("+errorDetail(err)+")")
+		}
+		offset = 0
+	} else if offset > 0 {
+		LogInfo.Println("INFO: Partial file exists, going to resume download. (Existing Part's SIZE: " + strconv.FormatInt(offset, 10) + ")")
+	}
+
+	_, err = file.Seek(offset, io.SeekStart)
+	if err != nil {
+		fatal(classifyError(err), "Cannot seek partial file! ("+errorDetail(err)+")")
+	}
+
+	var bytes int64
+	if offset < objAttrs.Size {
+		reader, err := openObjectReader(ctx, obj.Generation(objAttrs.Generation), offset, -1)
+		if err != nil {
+			fatal(classifyError(err), "Cannot create new reader! ("+errorDetail(err)+")")
+		}
+		defer reader.Close()
+
+		bytes, err = copyDownload(file, transferReader(ctx, reader))
+		if err != nil {
+			fatal(classifyError(err), "Cannot copy object from bucket! ("+errorDetail(err)+")")
+		}
+
+		err = reader.Close()
+		if err != nil {
+			fatal(classifyError(err), "Cannot read object from bucket! ("+errorDetail(err)+")")
+		}
+	}
+
+	crc, err := fileCRC32C(file)
+	if err != nil {
+		fatal(classifyError(err), "Cannot compute checksum of partial file! ("+errorDetail(err)+")")
+	}
+	if crc != objAttrs.CRC32C {
+		file.Close()
+		os.Remove(file.Name())
+		fatal(ErrCategoryUnknown, "Checksum mismatch, partial file discarded! (Object's CRC32: "+formatCRC32C(objAttrs.CRC32C)+", Local File's CRC32: "+formatCRC32C(crc)+")")
 	}
 
-	LogInfo.Println("SUCCESS: Object downloaded from GCP Bucket. (Written Bytes: " + strconv.FormatInt(bytes, 10) + ")")
+	return bytes, crc
 
 }
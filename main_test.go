@@ -0,0 +1,33 @@
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/api/googleapi"
+)
+
+func TestChunkSizeForDeclaredSize(t *testing.T) {
+
+	const mib = 1024 * 1024
+
+	tests := []struct {
+		size int64
+		want int
+	}{
+		{0, 0},
+		{1, 0},
+		{googleapi.DefaultUploadChunkSize, 0},
+		{googleapi.DefaultUploadChunkSize + 1, googleapi.DefaultUploadChunkSize},
+		{100 * mib, googleapi.DefaultUploadChunkSize},
+		{maxUploadChunks * 64 * mib, 64 * mib},
+		{maxUploadChunks * maxUploadChunkSize, maxUploadChunkSize},
+		{maxUploadChunks * 1024 * mib, maxUploadChunkSize},
+	}
+
+	for _, test := range tests {
+		if got := chunkSizeForDeclaredSize(test.size); got != test.want {
+			t.Errorf("chunkSizeForDeclaredSize(%d) = %d, want %d", test.size, got, test.want)
+		}
+	}
+
+}
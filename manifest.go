@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+type manifestEntryStruct struct {
+	Object     string `json:"object"`
+	Size       int64  `json:"size"`
+	CRC32C     uint32 `json:"crc32c"`
+	MD5        string `json:"md5,omitempty"`
+	Generation int64  `json:"generation"`
+}
+
+type manifestStruct struct {
+	Bucket  string                `json:"bucket"`
+	Created string                `json:"created"`
+	Objects []manifestEntryStruct `json:"objects"`
+}
+
+var manifestEntries struct {
+	mutex   sync.Mutex
+	entries []manifestEntryStruct
+}
+
+func recordManifestEntry(objAttrs *storage.ObjectAttrs) {
+
+	if appFlag.ManifestObject == "" || objAttrs == nil {
+		return
+	}
+
+	manifestEntries.mutex.Lock()
+	manifestEntries.entries = append(manifestEntries.entries, manifestEntryStruct{
+		Object:     objAttrs.Name,
+		Size:       objAttrs.Size,
+		CRC32C:     objAttrs.CRC32C,
+		MD5:        hex.EncodeToString(objAttrs.MD5),
+		Generation: objAttrs.Generation,
+	})
+	manifestEntries.mutex.Unlock()
+
+}
+
+func writeManifest(storageUnderlyingDataObject *storageUnderlyingDataStruct, bucketName string, manifestObject string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	manifestEntries.mutex.Lock()
+	entries := append([]manifestEntryStruct(nil), manifestEntries.entries...)
+	manifestEntries.mutex.Unlock()
+
+	if len(entries) == 0 {
+		LogWarn.Println("WARNING: No object uploaded, manifest is not written!")
+		return
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Object < entries[j].Object
+	})
+
+	content, err := json.MarshalIndent(manifestStruct{Bucket: bucketName, Created: time.Now().UTC().Format(time.RFC3339), Objects: entries}, "", "  ")
+	if err != nil {
+		fatal(classifyError(err), "Cannot encode manifest! ("+errorDetail(err)+")")
+	}
+
+	writer := client.Bucket(bucketName).Object(manifestObject).NewWriter(ctx)
+	writer.ContentType = "application/json"
+	_, err = writer.Write(append(content, '\n'))
+	if err != nil {
+		writer.Close()
+		fatal(classifyError(err), "Cannot write manifest to bucket! ("+errorDetail(err)+")")
+	}
+	err = writer.Close()
+	if err != nil {
+		fatal(classifyError(err), "Cannot write manifest to bucket! ("+errorDetail(err)+")")
+	}
+
+	LogInfo.Println("SUCCESS: Manifest uploaded to GCP Bucket. (Manifest: " + manifestObject + ", Objects: " + strconv.Itoa(len(entries)) + ")")
+
+}
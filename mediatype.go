@@ -0,0 +1,171 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var mediaTopLevelTypes = map[string]bool{
+	"application": true,
+	"audio":       true,
+	"font":        true,
+	"image":       true,
+	"message":     true,
+	"model":       true,
+	"multipart":   true,
+	"text":        true,
+	"video":       true,
+}
+
+var knownMediaTypes = map[string]bool{
+	"application/gzip":         true,
+	"application/javascript":   true,
+	"application/json":         true,
+	"application/octet-stream": true,
+	"application/pdf":          true,
+	"application/wasm":         true,
+	"application/x-tar":        true,
+	"application/xml":          true,
+	"application/yaml":         true,
+	"application/zip":          true,
+	"audio/mpeg":               true,
+	"audio/ogg":                true,
+	"audio/wav":                true,
+	"font/woff":                true,
+	"font/woff2":               true,
+	"image/gif":                true,
+	"image/jpeg":               true,
+	"image/png":                true,
+	"image/svg+xml":            true,
+	"image/webp":               true,
+	"text/css":                 true,
+	"text/csv":                 true,
+	"text/html":                true,
+	"text/javascript":          true,
+	"text/markdown":            true,
+	"text/plain":               true,
+	"text/xml":                 true,
+	"video/mp4":                true,
+	"video/webm":               true,
+}
+
+func validateMediaType(contentType string) error {
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return err
+	}
+
+	topLevelType, subType, ok := strings.Cut(mediaType, "/")
+	if !ok || subType == "" {
+		return errors.New("media type must be in type/subtype form")
+	}
+	if !mediaTopLevelTypes[topLevelType] {
+		return errors.New("unknown top-level media type '" + topLevelType + "'")
+	}
+
+	if knownMediaTypes[mediaType] {
+		return nil
+	}
+	if extensions, _ := mime.ExtensionsByType(mediaType); len(extensions) > 0 {
+		return nil
+	}
+	for _, treePrefix := range []string{"vnd.", "prs.", "x-"} {
+		if strings.HasPrefix(subType, treePrefix) {
+			return nil
+		}
+	}
+
+	return errors.New("unknown media type '" + mediaType + "'")
+
+}
+
+func detectMediaType(file *os.File) (string, error) {
+
+	defer file.Seek(0, io.SeekStart)
+
+	buffer := make([]byte, 512)
+	n, err := io.ReadFull(file, buffer)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	mediaType := http.DetectContentType(buffer[:n])
+	if strings.HasPrefix(mediaType, "application/octet-stream") || strings.HasPrefix(mediaType, "text/plain") {
+		if extensionType := mime.TypeByExtension(filepath.Ext(file.Name())); extensionType != "" {
+			mediaType = extensionType
+		}
+	}
+
+	mediaType, _, _ = strings.Cut(mediaType, ";")
+
+	return strings.ToLower(strings.TrimSpace(mediaType)), nil
+
+}
+
+func mediaTypeAllowed(mediaType string, allowedTypes []string) bool {
+
+	for _, allowedType := range allowedTypes {
+		allowedType = strings.ToLower(allowedType)
+		if allowedType == mediaType {
+			return true
+		}
+		if topLevel, ok := strings.CutSuffix(allowedType, "/*"); ok && strings.HasPrefix(mediaType, topLevel+"/") {
+			return true
+		}
+	}
+
+	return false
+
+}
+
+func checkAllowedType(file *os.File, filePath string) error {
+
+	mediaType, err := detectMediaType(file)
+	if err != nil {
+		return newCategorizedError(classifyError(err), "Cannot detect type of requested file! ("+errorDetail(err)+")")
+	}
+	if !mediaTypeAllowed(mediaType, appFlag.AllowTypes) {
+		return newCategorizedError(ErrCategoryUnknown, "Detected type of requested file is not allowed! (File: "+filePath+", Detected Type: "+mediaType+", Allowed Types: "+strings.Join(appFlag.AllowTypes, ",")+")")
+	}
+
+	LogDebug.Println("DEBUG: Detected type of requested file is allowed. (Detected Type: " + mediaType + ")")
+
+	return nil
+
+}
+
+var languageTagPattern = regexp.MustCompile(`^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$`)
+
+func validateLanguageTag(languageTag string) error {
+
+	if !languageTagPattern.MatchString(languageTag) {
+		return errors.New("language tag must look like BCP-47, e.g. 'en' or 'pt-BR'")
+	}
+
+	return nil
+
+}
+
+func fileTypeAllowed(filePath string) (string, bool) {
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		fatal(classifyError(err), "Cannot open requested file! (File: "+filePath+", "+errorDetail(err)+")")
+	}
+	defer file.Close()
+
+	mediaType, err := detectMediaType(file)
+	if err != nil {
+		fatal(classifyError(err), "Cannot detect type of requested file! (File: "+filePath+", "+errorDetail(err)+")")
+	}
+
+	return mediaType, mediaTypeAllowed(mediaType, appFlag.AllowTypes)
+
+}
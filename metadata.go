@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"sort"
+	"strings"
+)
+
+type metadataFlag map[string]string
+
+func (m metadataFlag) String() string {
+
+	var pairs []string
+	for key, value := range m {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+
+}
+
+func (m metadataFlag) Set(pair string) error {
+
+	key, value, ok := strings.Cut(pair, "=")
+	key = strings.TrimSpace(key)
+	if !ok || key == "" {
+		return errors.New("metadata must be in key=value form")
+	}
+
+	if _, exists := m[key]; exists {
+		LogWarn.Println("WARNING: Duplicate metadata key is discarded! (Key: " + key + ")")
+		return nil
+	}
+	m[key] = value
+
+	return nil
+
+}
+
+func objectMetadata() map[string]string {
+
+	metadata := map[string]string{}
+	for key, value := range uploadSpec.Metadata {
+		metadata[key] = value
+	}
+
+	for key, value := range gitMetadata {
+		metadata[key] = value
+	}
+
+	if appFlag.MetadataPath != "" {
+		content, err := os.ReadFile(appFlag.MetadataPath)
+		if err != nil {
+			fatal(classifyError(err), "Cannot read requested metadata file! ("+errorDetail(err)+")")
+		}
+		err = json.Unmarshal(content, &metadata)
+		if err != nil {
+			fatal(classifyError(err), "Cannot parse requested metadata file! ("+errorDetail(err)+")")
+		}
+	}
+
+	for key, value := range appFlag.Metadata {
+		metadata[key] = value
+	}
+
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	return metadata
+
+}
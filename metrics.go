@@ -0,0 +1,81 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const metricsPrefix = "gcp_bucket_loader_"
+
+type metricsStruct struct {
+	startTime         time.Time
+	objectsUploaded   atomic.Int64
+	objectsDownloaded atomic.Int64
+	objectsDeleted    atomic.Int64
+	bytesTransferred  atomic.Int64
+	errorsTotal       atomic.Int64
+	categoryMutex     sync.Mutex
+	errorCategories   map[string]int64
+}
+
+var runMetrics metricsStruct
+
+func countError(category string) {
+
+	runMetrics.errorsTotal.Add(1)
+
+	runMetrics.categoryMutex.Lock()
+	if runMetrics.errorCategories == nil {
+		runMetrics.errorCategories = make(map[string]int64)
+	}
+	runMetrics.errorCategories[category]++
+	runMetrics.categoryMutex.Unlock()
+
+}
+
+func writeMetricsFile(metricsPath string) {
+
+	var builder strings.Builder
+	writeMetric := func(name string, metricType string, help string, value string) {
+		builder.WriteString("# HELP " + metricsPrefix + name + " " + help + "\n")
+		builder.WriteString("# TYPE " + metricsPrefix + name + " " + metricType + "\n")
+		builder.WriteString(metricsPrefix + name + " " + value + "\n")
+	}
+
+	writeMetric("objects_uploaded", "counter", "Number of objects uploaded to GCP.", strconv.FormatInt(runMetrics.objectsUploaded.Load(), 10))
+	writeMetric("objects_downloaded", "counter", "Number of objects downloaded from GCP.", strconv.FormatInt(runMetrics.objectsDownloaded.Load(), 10))
+	writeMetric("objects_deleted", "counter", "Number of objects deleted from GCP.", strconv.FormatInt(runMetrics.objectsDeleted.Load(), 10))
+	writeMetric("bytes_transferred", "counter", "Number of bytes transferred to or from GCP.", strconv.FormatInt(runMetrics.bytesTransferred.Load(), 10))
+	writeMetric("errors_total", "counter", "Number of failed operations.", strconv.FormatInt(runMetrics.errorsTotal.Load(), 10))
+
+	runMetrics.categoryMutex.Lock()
+	categories := make([]string, 0, len(runMetrics.errorCategories))
+	for category := range runMetrics.errorCategories {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	builder.WriteString("# HELP " + metricsPrefix + "errors_by_category_total Number of failed operations by error category.\n")
+	builder.WriteString("# TYPE " + metricsPrefix + "errors_by_category_total counter\n")
+	for _, category := range categories {
+		builder.WriteString(metricsPrefix + "errors_by_category_total{category=\"" + category + "\"} " + strconv.FormatInt(runMetrics.errorCategories[category], 10) + "\n")
+	}
+	runMetrics.categoryMutex.Unlock()
+
+	writeMetric("duration_seconds", "gauge", "Duration of the run in seconds.", strconv.FormatFloat(time.Since(runMetrics.startTime).Seconds(), 'f', 3, 64))
+
+	tempPath := filepath.Join(filepath.Dir(metricsPath), "."+filepath.Base(metricsPath)+".tmp")
+	err := os.WriteFile(tempPath, []byte(builder.String()), 0644)
+	if err == nil {
+		err = os.Rename(tempPath, metricsPath)
+	}
+	if err != nil {
+		LogWarn.Println("WARNING: Cannot write metrics file! (" + err.Error() + ")")
+	}
+
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"os"
+	"syscall"
+)
+
+func moveFile(srcPath string, dstPath string) error {
+
+	err := os.Rename(srcPath, dstPath)
+	if err == nil || !errors.Is(err, syscall.EXDEV) {
+		return err
+	}
+
+	LogDebug.Println("DEBUG: Temporary file is on another device, copying it instead of renaming. (Temp Path: " + srcPath + ")")
+
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dstPartPath := dstPath + PartSuffix
+	dst, err := os.Create(dstPartPath)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(dst, src)
+	if err == nil {
+		err = dst.Sync()
+	}
+	closeErr := dst.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(dstPartPath)
+		return err
+	}
+
+	err = os.Rename(dstPartPath, dstPath)
+	if err != nil {
+		os.Remove(dstPartPath)
+		return err
+	}
+
+	return os.Remove(srcPath)
+
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type uploadEntryStruct struct {
+	filePath   string
+	objectName string
+}
+
+func uploadsMultipleFiles(filePaths []string) bool {
+
+	if len(filePaths) != 1 {
+		return len(filePaths) > 1
+	}
+
+	info, err := os.Stat(filePaths[0])
+
+	return err == nil && info.IsDir()
+
+}
+
+func expandUploadPaths(filePaths []string) []uploadEntryStruct {
+
+	var entries []uploadEntryStruct
+	for _, filePath := range filePaths {
+		info, err := os.Stat(filePath)
+		if err != nil || !info.IsDir() {
+			entries = append(entries, uploadEntryStruct{filePath: filePath, objectName: filepath.Base(filePath)})
+			continue
+		}
+
+		err = filepath.WalkDir(filePath, func(walkPath string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !entry.Type().IsRegular() {
+				return nil
+			}
+			objectName := filepath.Base(walkPath)
+			if !appFlag.Flatten {
+				relPath, err := filepath.Rel(filePath, walkPath)
+				if err != nil {
+					return err
+				}
+				objectName = filepath.ToSlash(relPath)
+			}
+			entries = append(entries, uploadEntryStruct{filePath: walkPath, objectName: objectName})
+			return nil
+		})
+		if err != nil {
+			fatal(classifyError(err), "Cannot walk requested directory! (Directory: "+filePath+", "+errorDetail(err)+")")
+		}
+	}
+
+	return entries
+
+}
+
+func uploadFiles(storageUnderlyingDataObject *storageUnderlyingDataStruct, filePaths []string, bucketName string, objectPrefix string, contentType string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	objectPaths := map[string]string{}
+	filesByObject := map[string]string{}
+	var uploadPaths []string
+	var skippedCount atomic.Int64
+	var dedupeMappings []dedupeMappingStruct
+
+	for _, uploadEntry := range expandUploadPaths(filePaths) {
+		filePath := uploadEntry.filePath
+		if len(appFlag.AllowTypes) > 0 {
+			mediaType, allowed := fileTypeAllowed(filePath)
+			if !allowed {
+				LogInfo.Println("SKIPPED: File type is not allowed, upload skipped. (File: " + filePath + ", Detected Type: " + mediaType + ")")
+				skippedCount.Add(1)
+				continue
+			}
+		}
+
+		objectName := uploadEntry.objectName
+		if appFlag.ContentAddressed {
+			sum, err := fileSHA256(filePath)
+			if err != nil {
+				fatal(classifyError(err), "Cannot compute SHA-256 of requested file! (File: "+filePath+", "+errorDetail(err)+")")
+			}
+			objectName = sum
+		}
+		objectPath := joinObjectPrefix(objectPrefix, objectName)
+
+		err := validateObjectName(objectPath)
+		if err != nil {
+			fatal(classifyError(err), "Wrong object name computed for file! (File: "+filePath+", "+errorDetail(err)+")")
+		}
+		if otherFilePath, exists := filesByObject[objectPath]; exists {
+			if !appFlag.DedupeSuffix {
+				fatal(ErrCategoryUnknown, "Multiple files would be uploaded to same object! (Object: "+objectPath+", Files: "+otherFilePath+","+filePath+")")
+			}
+			var duplicate bool
+			objectPath, duplicate = dedupeCollision(filePath, objectPath, filesByObject)
+			if !duplicate && remoteObjectIdentical(ctx, client.Bucket(bucketName).Object(objectPath), filePath) {
+				duplicate = true
+			}
+			if duplicate {
+				LogInfo.Println("SKIPPED: File content is identical to another object, upload deduplicated. (File: " + filePath + ", Object: " + objectPath + ")")
+				recordObject(objectPath, RecordSkipped, 0, "", 0, nil)
+				dedupeMappings = append(dedupeMappings, dedupeMappingStruct{filePath: filePath, objectPath: objectPath, status: RecordDeduplicated})
+				skippedCount.Add(1)
+				continue
+			}
+		}
+
+		filesByObject[objectPath] = filePath
+		objectPaths[filePath] = objectPath
+		uploadPaths = append(uploadPaths, filePath)
+	}
+
+	if appFlag.ExtraChecks || appFlag.SkipUnchanged || appFlag.FailOnOverwrite {
+		var preflightPaths []string
+		for _, filePath := range uploadPaths {
+			preflightPaths = append(preflightPaths, objectPaths[filePath])
+		}
+		prefetchPreflightAttrs(ctx, client.Bucket(bucketName), preflightPaths)
+	}
+
+	var uploadedCount, uploadedBytes, overwrittenCount, abortedCount, failedCount atomic.Int64
+	var limitExceeded atomic.Bool
+	var notStarted, timedOut timedOutList
+	var table summaryTable
+	var resultMutex sync.Mutex
+	results := map[string]dedupeMappingStruct{}
+
+	batchStart := time.Now()
+	runWorkerPool(int(appFlag.Concurrency), uploadPaths, func(filePath string) {
+		if limitExceeded.Load() {
+			notStarted.add(filePath)
+			skippedCount.Add(1)
+			recordObject(objectPaths[filePath], RecordSkipped, 0, "", 0, errMaxTotalBytes)
+			resultMutex.Lock()
+			results[filePath] = dedupeMappingStruct{filePath: filePath, objectPath: objectPaths[filePath], status: RecordSkipped}
+			resultMutex.Unlock()
+			table.add(objectPaths[filePath], RecordSkipped, 0, 0)
+			return
+		}
+
+		fileCtx, fileCancel := objectContext(ctx)
+		defer fileCancel()
+
+		LogInfo.Println("INFO: Uploading file. (File: " + filePath + ", Object: " + objectPaths[filePath] + ")")
+
+		fileStart := time.Now()
+		untrackRecordObject := trackRecordObject(objectPaths[filePath])
+		var result uploadResultStruct
+		var err error
+		if appFlag.SplitParts > 1 {
+			result, err = uploadObjectComposite(fileCtx, client, filePath, bucketName, objectPaths[filePath], contentType)
+		} else {
+			result, err = uploadObject(fileCtx, client, filePath, bucketName, objectPaths[filePath], contentType)
+		}
+		untrackRecordObject()
+
+		if errors.Is(err, errMaxTotalBytes) {
+			LogErr.Println("ERROR: Max-total-bytes limit exceeded, upload aborted! (Object: " + result.objectPath + ", Max Total Bytes: " + strconv.FormatUint(appFlag.MaxTotalBytes, 10) + ")")
+			limitExceeded.Store(true)
+			abortedCount.Add(1)
+			countError(classifyError(err))
+			recordObject(result.objectPath, RecordFailed, 0, "", time.Since(fileStart), err)
+			result.status = RecordFailed
+		} else if objectTimedOut(fileCtx, err) && ctx.Err() == nil {
+			LogErr.Println("ERROR: Uploading file timed out! (File: " + filePath + ", " + err.Error() + ")")
+			timedOut.add(result.objectPath)
+			failedCount.Add(1)
+			countError(ErrCategoryTimeout)
+			recordObject(result.objectPath, RecordTimedOut, 0, "", time.Since(fileStart), err)
+			result.status = RecordTimedOut
+		} else if err != nil {
+			LogErr.Println("ERROR: Cannot upload file to bucket! (File: " + filePath + ", " + err.Error() + ")")
+			failedCount.Add(1)
+			countError(classifyError(err))
+			recordObject(result.objectPath, RecordFailed, 0, "", time.Since(fileStart), err)
+			result.status = RecordFailed
+		} else if result.status == RecordUploaded {
+			uploadedCount.Add(1)
+			uploadedBytes.Add(result.bytes)
+			if result.overwritten {
+				overwrittenCount.Add(1)
+			}
+		} else {
+			skippedCount.Add(1)
+		}
+
+		resultMutex.Lock()
+		results[filePath] = dedupeMappingStruct{filePath: filePath, objectPath: result.objectPath, status: result.status}
+		resultMutex.Unlock()
+
+		table.add(result.objectPath, result.status, result.bytes, time.Since(fileStart))
+	})
+
+	batchElapsed := time.Since(batchStart)
+
+	table.print()
+
+	if appFlag.Throughput {
+		reportBatchThroughput(uploadedCount.Load(), uploadedBytes.Load(), batchElapsed)
+	}
+
+	if appFlag.DedupeSuffix {
+		for _, filePath := range uploadPaths {
+			dedupeMappings = append(dedupeMappings, results[filePath])
+		}
+		printDedupeMapping(dedupeMappings)
+	}
+
+	summary := "Uploaded Files: " + strconv.FormatInt(uploadedCount.Load(), 10) + ", Skipped Files: " + strconv.FormatInt(skippedCount.Load(), 10)
+
+	if limitExceeded.Load() {
+		fatal(ErrCategoryUnknown, "Max-total-bytes limit exceeded, batch upload aborted! (Uploaded Files: "+strconv.FormatInt(uploadedCount.Load(), 10)+", Aborted Files: "+strconv.FormatInt(abortedCount.Load(), 10)+", Failed Files: "+strconv.FormatInt(failedCount.Load(), 10)+", Skipped Files: "+notStarted.String()+")")
+	}
+	if failedCount.Load() > 0 {
+		if len(timedOut.items) > 0 {
+			summary += ", Timed Out Files: " + timedOut.String()
+		}
+		fatal(ErrCategoryUnknown, "Cannot upload some files to GCP Bucket! ("+summary+", Failed Files: "+strconv.FormatInt(failedCount.Load(), 10)+")")
+	}
+	if appFlag.FailOnOverwrite && overwrittenCount.Load() > 0 {
+		LogWarn.Println("WARNING: Some objects already existed and were overwritten! (Overwritten Files: " + strconv.FormatInt(overwrittenCount.Load(), 10) + ")")
+		exitOverwritten()
+	}
+
+	LogInfo.Println("SUCCESS: Files uploaded to GCP Bucket. (" + summary + ")")
+
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	GSScheme            = "gs://"
+	maxObjectNameLength = 1024
+)
+
+func normalizeObjectPath(objectPath string) (string, error) {
+
+	var segments []string
+	for _, segment := range strings.Split(objectPath, "/") {
+		switch segment {
+		case "", ".":
+			continue
+		case "..":
+			return "", errors.New("parent directory segment is not allowed")
+		}
+		segments = append(segments, segment)
+	}
+
+	normalizedPath := strings.Join(segments, "/")
+	if normalizedPath != "" && strings.HasSuffix(objectPath, "/") {
+		normalizedPath += "/"
+	}
+
+	return normalizedPath, nil
+
+}
+
+func parseGSURI(uri string) (string, string, error) {
+
+	rest, ok := strings.CutPrefix(uri, GSScheme)
+	if !ok {
+		return "", "", errors.New("uri must start with " + GSScheme)
+	}
+
+	bucketName, objectPath, _ := strings.Cut(rest, "/")
+	if bucketName == "" {
+		return "", "", errors.New("uri must contain bucket name")
+	}
+
+	return bucketName, objectPath, nil
+
+}
+
+func validateObjectName(objectPath string) error {
+
+	if len(objectPath) > maxObjectNameLength {
+		return errors.New("object name is " + strconv.Itoa(len(objectPath)) + " bytes, longer than " + strconv.Itoa(maxObjectNameLength) + " bytes")
+	}
+	if !utf8.ValidString(objectPath) {
+		return errors.New("object name is not valid UTF-8")
+	}
+	if objectPath == "." || objectPath == ".." {
+		return errors.New("object name cannot be '.' or '..'")
+	}
+	if strings.HasPrefix(objectPath, ".well-known/acme-challenge/") {
+		return errors.New("object name cannot start with '.well-known/acme-challenge/'")
+	}
+
+	for index, char := range objectPath {
+		switch char {
+		case '\r':
+			return errors.New("object name contains carriage return at byte " + strconv.Itoa(index))
+		case '\n':
+			return errors.New("object name contains line feed at byte " + strconv.Itoa(index))
+		}
+	}
+
+	return nil
+
+}
+
+func joinObjectPrefix(prefix string, objectPath string) string {
+
+	if prefix == "" {
+		return objectPath
+	}
+
+	return strings.TrimRight(prefix, "/") + "/" + strings.TrimLeft(objectPath, "/")
+
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeObjectPath(t *testing.T) {
+
+	tests := []struct {
+		objectPath string
+		want       string
+		wantErr    bool
+	}{
+		{"a/b/c", "a/b/c", false},
+		{"a//b/./c", "a/b/c", false},
+		{"/a/b/", "a/b/", false},
+		{"./a", "a", false},
+		{"", "", false},
+		{"/", "", false},
+		{"./", "", false},
+		{"..a/b..", "..a/b..", false},
+		{"a/../b", "", true},
+		{"..", "", true},
+	}
+
+	for _, test := range tests {
+		got, err := normalizeObjectPath(test.objectPath)
+		if (err != nil) != test.wantErr || got != test.want {
+			t.Errorf("normalizeObjectPath(%q) = %q, %v, want %q, error %t", test.objectPath, got, err, test.want, test.wantErr)
+		}
+	}
+
+}
+
+func TestParseGSURI(t *testing.T) {
+
+	tests := []struct {
+		uri        string
+		bucketName string
+		objectPath string
+		wantErr    bool
+	}{
+		{"gs://bucket/a/b.txt", "bucket", "a/b.txt", false},
+		{"gs://bucket/a/", "bucket", "a/", false},
+		{"gs://bucket/", "bucket", "", false},
+		{"gs://bucket", "bucket", "", false},
+		{"gs:///a", "", "", true},
+		{"gs://", "", "", true},
+		{"s3://bucket/a", "", "", true},
+		{"bucket/a", "", "", true},
+	}
+
+	for _, test := range tests {
+		bucketName, objectPath, err := parseGSURI(test.uri)
+		if (err != nil) != test.wantErr || bucketName != test.bucketName || objectPath != test.objectPath {
+			t.Errorf("parseGSURI(%q) = %q, %q, %v, want %q, %q, error %t", test.uri, bucketName, objectPath, err, test.bucketName, test.objectPath, test.wantErr)
+		}
+	}
+
+}
+
+func TestValidateObjectName(t *testing.T) {
+
+	tests := []struct {
+		objectPath string
+		wantErr    bool
+	}{
+		{"a/b.txt", false},
+		{"..a", false},
+		{".well-known/other", false},
+		{strings.Repeat("a", maxObjectNameLength), false},
+		{strings.Repeat("a", maxObjectNameLength+1), true},
+		{"a\xffb", true},
+		{".", true},
+		{"..", true},
+		{".well-known/acme-challenge/token", true},
+		{"a\rb", true},
+		{"a\nb", true},
+	}
+
+	for _, test := range tests {
+		err := validateObjectName(test.objectPath)
+		if (err != nil) != test.wantErr {
+			t.Errorf("validateObjectName(%q) = %v, want error %t", test.objectPath, err, test.wantErr)
+		}
+	}
+
+}
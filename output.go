@@ -0,0 +1,87 @@
+package main
+
+import (
+	"io"
+	"log"
+	"os"
+	"strings"
+)
+
+type errorResultStruct struct {
+	Status   string `json:"status"`
+	Category string `json:"category"`
+	Error    string `json:"error"`
+}
+
+func printErrorResult(category string, err error) {
+
+	printJSON(errorResultStruct{
+		Status:   ExitStatusError,
+		Category: category,
+		Error:    err.Error(),
+	})
+
+}
+
+func listOutputFormat() string {
+
+	outputFormat := strings.ToLower(appFlag.OutputFormat)
+	if outputFormat == "" {
+		outputFormat = OutputText
+		if appFlag.JSONOutput {
+			outputFormat = OutputJSON
+		}
+	}
+
+	return outputFormat
+
+}
+
+func machineOutput() bool {
+
+	if strings.EqualFold(appFlag.ActionType, List) {
+		return listOutputFormat() != OutputText
+	}
+	if strings.EqualFold(appFlag.ActionType, Stat) || (strings.EqualFold(appFlag.ActionType, Download) && appFlag.Head) {
+		return appFlag.JSONOutput
+	}
+	if strings.EqualFold(appFlag.ActionType, Upload) && appFlag.Diff && appFlag.ExtraChecks {
+		return true
+	}
+	if strings.EqualFold(appFlag.ActionType, Upload) && appFlag.Throughput {
+		return appFlag.JSONOutput
+	}
+	if strings.EqualFold(appFlag.ActionType, Download) && appFlag.Base64Output {
+		return true
+	}
+	if strings.EqualFold(appFlag.ActionType, Compare) || strings.EqualFold(appFlag.ActionType, WhoAmI) || strings.EqualFold(appFlag.ActionType, SignPol) {
+		return true
+	}
+
+	return false
+
+}
+
+func stdoutWriter() io.Writer {
+
+	if machineOutput() {
+		return os.Stderr
+	}
+
+	return os.Stdout
+
+}
+
+func keepStdoutForOutput() {
+
+	if !machineOutput() {
+		return
+	}
+
+	for _, logger := range []*log.Logger{LogWarn, LogInfo, LogAlways} {
+		if logger.Writer() == os.Stdout {
+			logger.SetOutput(os.Stderr)
+		}
+	}
+
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+func pingBucket(storageUnderlyingDataObject *storageUnderlyingDataStruct, bucketName string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	start := time.Now()
+
+	it := client.Bucket(bucketName).Objects(ctx, &storage.Query{})
+	it.PageInfo().MaxSize = 1
+
+	_, err := it.Next()
+	if err != nil && err != iterator.Done {
+		if err == storage.ErrBucketNotExist {
+			fatal(classifyError(err), "Bucket does not exist! ("+errorDetail(err)+")")
+		} else {
+			fatal(classifyError(err), "Cannot reach bucket on GCP! ("+errorDetail(err)+")")
+		}
+	}
+
+	latency := strconv.FormatInt(time.Since(start).Milliseconds(), 10)
+	LogInfo.Println("SUCCESS: Bucket is reachable on GCP. (Latency: " + latency + "ms)")
+
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultConcurrency    = 8
+	defaultCopyBufferSize = 32 * 1024
+)
+
+var copyBufferPool = sync.Pool{
+	New: func() any {
+		size := int(appFlag.CopyBufferSize)
+		if size <= 0 {
+			size = defaultCopyBufferSize
+		}
+		buffer := make([]byte, size)
+		return &buffer
+	},
+}
+
+var readBufferPool = sync.Pool{
+	New: func() any {
+		buffer := make([]byte, int(appFlag.ReadBufferSize))
+		return &buffer
+	},
+}
+
+func runWorkerPool[T any](concurrency int, items []T, work func(T)) {
+
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	jobs := make(chan T)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range jobs {
+				work(item)
+			}
+		}()
+	}
+
+	for _, item := range items {
+		jobs <- item
+	}
+	close(jobs)
+
+	wg.Wait()
+
+}
+
+type timedOutList struct {
+	mutex sync.Mutex
+	items []string
+}
+
+func (l *timedOutList) add(item string) {
+
+	l.mutex.Lock()
+	l.items = append(l.items, item)
+	l.mutex.Unlock()
+
+}
+
+func (l *timedOutList) String() string {
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return strings.Join(l.items, ",")
+
+}
+
+func objectContext(ctx context.Context) (context.Context, context.CancelFunc) {
+
+	if appFlag.PerObjectTimeout == 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, time.Duration(appFlag.PerObjectTimeout)*time.Second)
+
+}
+
+func objectTimedOut(ctx context.Context, err error) bool {
+
+	return err != nil && ctx.Err() == context.DeadlineExceeded
+
+}
+
+var totalCopiedBytes atomic.Int64
+
+var errMaxTotalBytes = errors.New("max-total-bytes limit exceeded")
+
+type totalBytesCapReader struct {
+	reader io.Reader
+}
+
+func (r *totalBytesCapReader) Read(p []byte) (int, error) {
+
+	n, err := r.reader.Read(p)
+	total := totalCopiedBytes.Add(int64(n))
+	if uint64(total) > appFlag.MaxTotalBytes {
+		LogDebug.Println("DEBUG: Max-total-bytes limit reached while copying. (Transferred Bytes: " + strconv.FormatInt(total-int64(n), 10) + ", Max Total Bytes: " + strconv.FormatUint(appFlag.MaxTotalBytes, 10) + ")")
+		return 0, errMaxTotalBytes
+	}
+
+	return n, err
+
+}
+
+func transferReader(ctx context.Context, src io.Reader) io.Reader {
+
+	if appFlag.MaxTotalBytes > 0 {
+		src = &totalBytesCapReader{reader: src}
+	}
+	if appFlag.RateLimit > 0 {
+		src = &rateLimitReader{ctx: ctx, reader: src}
+	}
+
+	return src
+
+}
+
+func copyWithPool(dst io.Writer, src io.Reader, pool *sync.Pool) (int64, error) {
+
+	buffer := pool.Get().(*[]byte)
+	defer pool.Put(buffer)
+
+	return io.CopyBuffer(struct{ io.Writer }{dst}, struct{ io.Reader }{src}, *buffer)
+
+}
+
+func copyBuffered(dst io.Writer, src io.Reader) (int64, error) {
+
+	return copyWithPool(dst, src, &copyBufferPool)
+
+}
+
+func copyDownload(dst io.Writer, src io.Reader) (int64, error) {
+
+	if appFlag.ReadBufferSize == 0 {
+		return copyBuffered(dst, src)
+	}
+
+	return copyWithPool(dst, src, &readBufferPool)
+
+}
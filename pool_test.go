@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+const (
+	benchmarkCopySize     = 4 * 1024
+	benchmarkDownloadSize = 64 * 1024 * 1024
+)
+
+func BenchmarkCopySmallFiles(b *testing.B) {
+
+	appFlag = &AppFlagStruct{}
+	content := bytes.Repeat([]byte{'x'}, benchmarkCopySize)
+
+	b.Run("io.Copy", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(benchmarkCopySize)
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, err := io.Copy(struct{ io.Writer }{io.Discard}, struct{ io.Reader }{bytes.NewReader(content)})
+				if err != nil {
+					b.Error(err)
+					return
+				}
+			}
+		})
+	})
+
+	b.Run("copyBuffered", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(benchmarkCopySize)
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, err := copyBuffered(io.Discard, bytes.NewReader(content))
+				if err != nil {
+					b.Error(err)
+					return
+				}
+			}
+		})
+	})
+
+}
+
+func TestMaxTotalBytesCountsTransfersOnly(t *testing.T) {
+
+	appFlag = &AppFlagStruct{MaxTotalBytes: 1024}
+	totalCopiedBytes.Store(0)
+	defer totalCopiedBytes.Store(0)
+
+	content := bytes.Repeat([]byte{'x'}, 768)
+
+	_, err := copyBuffered(io.Discard, bytes.NewReader(content))
+	if err != nil {
+		t.Fatalf("local copy: %v", err)
+	}
+	if total := totalCopiedBytes.Load(); total != 0 {
+		t.Fatalf("local copy counted %d bytes, want 0", total)
+	}
+
+	_, err = copyBuffered(io.Discard, transferReader(context.Background(), bytes.NewReader(content)))
+	if err != nil {
+		t.Fatalf("first transfer: %v", err)
+	}
+	_, err = copyBuffered(io.Discard, transferReader(context.Background(), bytes.NewReader(content)))
+	if !errors.Is(err, errMaxTotalBytes) {
+		t.Fatalf("second transfer error = %v, want %v", err, errMaxTotalBytes)
+	}
+
+}
+
+type chunkedReader struct {
+	reader    io.Reader
+	chunkSize int
+}
+
+func (r *chunkedReader) Read(p []byte) (int, error) {
+
+	if len(p) > r.chunkSize {
+		p = p[:r.chunkSize]
+	}
+
+	return r.reader.Read(p)
+
+}
+
+func BenchmarkDownloadReadBuffer(b *testing.B) {
+
+	appFlag = &AppFlagStruct{}
+	content := bytes.Repeat([]byte{'x'}, benchmarkDownloadSize)
+
+	file, err := os.CreateTemp(b.TempDir(), "download")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer file.Close()
+
+	for _, size := range []uint{32 * 1024, 1024 * 1024} {
+		appFlag.ReadBufferSize = size
+		readBufferPool = sync.Pool{
+			New: func() any {
+				buffer := make([]byte, int(appFlag.ReadBufferSize))
+				return &buffer
+			},
+		}
+
+		b.Run(strconv.FormatUint(uint64(size/1024), 10)+"KiB", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(benchmarkDownloadSize)
+			for i := 0; i < b.N; i++ {
+				_, err := file.Seek(0, io.SeekStart)
+				if err != nil {
+					b.Fatal(err)
+				}
+				_, err = copyDownload(file, &chunkedReader{reader: bytes.NewReader(content), chunkSize: 2 * 1024 * 1024})
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+type preflightResultStruct struct {
+	objAttrs *storage.ObjectAttrs
+	err      error
+}
+
+var preflightCache struct {
+	mutex    sync.Mutex
+	bktAttrs *storage.BucketAttrs
+	objects  map[string]preflightResultStruct
+}
+
+func prefetchPreflightAttrs(ctx context.Context, bkt *storage.BucketHandle, objectPaths []string) {
+
+	prefetchStart := time.Now()
+
+	bktAttrs, err := bucketAttrs(ctx, preflightBucket(bkt))
+	if err == nil {
+		preflightCache.mutex.Lock()
+		preflightCache.bktAttrs = bktAttrs
+		preflightCache.mutex.Unlock()
+	}
+
+	results := make(map[string]preflightResultStruct, len(objectPaths))
+	var resultsMutex sync.Mutex
+
+	runWorkerPool(int(appFlag.Concurrency), objectPaths, func(objectPath string) {
+		objAttrs, err := objectAttrs(ctx, preflightObject(bkt.Object(objectPath)))
+		if err != nil && err != storage.ErrObjectNotExist {
+			return
+		}
+		resultsMutex.Lock()
+		results[objectPath] = preflightResultStruct{objAttrs: objAttrs, err: err}
+		resultsMutex.Unlock()
+	})
+
+	preflightCache.mutex.Lock()
+	preflightCache.objects = results
+	preflightCache.mutex.Unlock()
+
+	LogDebug.Println("DEBUG: Object info prefetched for preflight. (Objects: " + strconv.Itoa(len(results)) + ", Duration: " + time.Since(prefetchStart).Round(time.Millisecond).String() + ")")
+
+}
+
+func cachedBucketAttrs(ctx context.Context, bkt *storage.BucketHandle) (*storage.BucketAttrs, error) {
+
+	preflightCache.mutex.Lock()
+	bktAttrs := preflightCache.bktAttrs
+	preflightCache.mutex.Unlock()
+
+	if bktAttrs != nil {
+		return bktAttrs, nil
+	}
+
+	return bucketAttrs(ctx, preflightBucket(bkt))
+
+}
+
+func cachedObjectAttrs(ctx context.Context, obj *storage.ObjectHandle) (*storage.ObjectAttrs, error) {
+
+	preflightCache.mutex.Lock()
+	result, ok := preflightCache.objects[obj.ObjectName()]
+	preflightCache.mutex.Unlock()
+
+	if ok {
+		return result.objAttrs, result.err
+	}
+
+	return objectAttrs(ctx, preflightObject(obj))
+
+}
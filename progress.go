@@ -0,0 +1,60 @@
+package main
+
+import (
+	"io"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const progressInterval = time.Second
+
+type progressReporter struct {
+	label    string
+	total    int64
+	mutex    sync.Mutex
+	lastTime time.Time
+}
+
+func newProgressReporter(label string, total int64) *progressReporter {
+
+	return &progressReporter{label: label, total: total}
+
+}
+
+func (p *progressReporter) report(done int64) {
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if done != p.total && time.Since(p.lastTime) < progressInterval {
+		return
+	}
+	p.lastTime = time.Now()
+
+	total := "N/A"
+	percent := "N/A"
+	if p.total > 0 {
+		total = strconv.FormatInt(p.total, 10)
+		percent = strconv.FormatFloat(float64(done)*100/float64(p.total), 'f', 1, 64) + "%"
+	}
+
+	LogInfo.Println("PROGRESS: " + p.label + " (Bytes: " + strconv.FormatInt(done, 10) + ", Total: " + total + ", Percent: " + percent + ")")
+
+}
+
+type progressReader struct {
+	reader   io.Reader
+	done     int64
+	reporter *progressReporter
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+
+	n, err := r.reader.Read(p)
+	r.done += int64(n)
+	r.reporter.report(r.done)
+
+	return n, err
+
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+var promptInput struct {
+	mutex  sync.Mutex
+	reader *bufio.Reader
+}
+
+func isTerminal(file *os.File) bool {
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+
+}
+
+func promptConfirm(question string) bool {
+
+	promptInput.mutex.Lock()
+	defer promptInput.mutex.Unlock()
+
+	if promptInput.reader == nil {
+		promptInput.reader = bufio.NewReader(os.Stdin)
+	}
+
+	fmt.Fprint(os.Stderr, question+" [y/N]: ")
+
+	answer, err := promptInput.reader.ReadString('\n')
+	if err != nil {
+		return false
+	}
+
+	answer = strings.TrimSpace(answer)
+	return strings.EqualFold(answer, "y") || strings.EqualFold(answer, "yes")
+
+}
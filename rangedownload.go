@@ -0,0 +1,34 @@
+package main
+
+import (
+	"strconv"
+
+	"cloud.google.com/go/storage"
+)
+
+func rangeRequested() bool {
+
+	return appFlag.RangeStart != 0 || appFlag.RangeLength >= 0
+
+}
+
+func servedRangeBytes(readerAttrs storage.ReaderObjectAttrs) int64 {
+
+	served := readerAttrs.Size - readerAttrs.StartOffset
+	if appFlag.RangeLength >= 0 && appFlag.RangeLength < served {
+		served = appFlag.RangeLength
+	}
+
+	return max(served, 0)
+
+}
+
+func logServedRange(readerAttrs storage.ReaderObjectAttrs, bytes int64) {
+
+	LogInfo.Println("INFO: Range served by GCP. (Start Offset: " + strconv.FormatInt(readerAttrs.StartOffset, 10) + ", Served Bytes: " + strconv.FormatInt(bytes, 10) + ", Object's SIZE: " + strconv.FormatInt(readerAttrs.Size, 10) + ")")
+
+	if appFlag.RangeLength >= 0 && bytes < appFlag.RangeLength {
+		LogInfo.Println("INFO: Object ends before requested range, fewer bytes served. (Requested Length: " + strconv.FormatInt(appFlag.RangeLength, 10) + ", Served Bytes: " + strconv.FormatInt(bytes, 10) + ")")
+	}
+
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const minRateLimit = 1024
+
+type rateLimiterStruct struct {
+	mutex          sync.Mutex
+	bytesPerSecond float64
+	next           time.Time
+	changed        chan struct{}
+}
+
+var rateLimiter rateLimiterStruct
+
+func (l *rateLimiterStruct) wait(ctx context.Context, n int) error {
+
+	l.mutex.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	l.next = l.next.Add(time.Duration(float64(n) / l.bytesPerSecond * float64(time.Second)))
+	deadline := l.next
+	rate := l.bytesPerSecond
+	if l.changed == nil {
+		l.changed = make(chan struct{})
+	}
+	changed := l.changed
+	l.mutex.Unlock()
+
+	for {
+		timer := time.NewTimer(time.Until(deadline))
+		select {
+		case <-timer.C:
+			return nil
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-changed:
+			timer.Stop()
+			l.mutex.Lock()
+			deadline = rescaleDeadline(deadline, time.Now(), rate/l.bytesPerSecond)
+			rate = l.bytesPerSecond
+			changed = l.changed
+			l.mutex.Unlock()
+		}
+	}
+
+}
+
+func (l *rateLimiterStruct) scale(factor float64) {
+
+	l.mutex.Lock()
+	previous := l.bytesPerSecond
+	l.bytesPerSecond = max(l.bytesPerSecond*factor, minRateLimit)
+	current := l.bytesPerSecond
+	l.next = rescaleDeadline(l.next, time.Now(), previous/current)
+	if l.changed != nil {
+		close(l.changed)
+	}
+	l.changed = make(chan struct{})
+	l.mutex.Unlock()
+
+	LogInfo.Println("INFO: Rate limit adjusted. (Previous Rate: " + strconv.FormatFloat(previous, 'f', 0, 64) + " B/s, Rate: " + strconv.FormatFloat(current, 'f', 0, 64) + " B/s)")
+
+}
+
+func rescaleDeadline(deadline time.Time, now time.Time, ratio float64) time.Time {
+
+	if !deadline.After(now) {
+		return deadline
+	}
+
+	return now.Add(time.Duration(float64(deadline.Sub(now)) * ratio))
+
+}
+
+type rateLimitReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+func (r *rateLimitReader) Read(p []byte) (int, error) {
+
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		waitErr := rateLimiter.wait(r.ctx, n)
+		if waitErr != nil {
+			return n, waitErr
+		}
+	}
+
+	return n, err
+
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterScaleWakesWaiters(t *testing.T) {
+
+	LogInfo = log.New(io.Discard, "", 0)
+
+	limiter := &rateLimiterStruct{bytesPerSecond: minRateLimit}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- limiter.wait(context.Background(), 60*minRateLimit)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	limiter.scale(1000)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("wait() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("wait() did not return after the rate was raised")
+	}
+
+}
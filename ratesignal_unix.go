@@ -0,0 +1,29 @@
+//go:build !windows
+
+package main
+
+import (
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+)
+
+func watchRateSignals() {
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGUSR1, syscall.SIGUSR2)
+
+	go func() {
+		for sig := range signals {
+			if sig == syscall.SIGUSR1 {
+				rateLimiter.scale(0.5)
+			} else {
+				rateLimiter.scale(2)
+			}
+		}
+	}()
+
+	LogDebug.Println("DEBUG: Rate limit can be halved with SIGUSR1 and doubled with SIGUSR2. (PID: " + strconv.Itoa(os.Getpid()) + ")")
+
+}
@@ -0,0 +1,9 @@
+//go:build windows
+
+package main
+
+func watchRateSignals() {
+
+	LogDebug.Println("DEBUG: Rate limit signals are not supported on Windows.")
+
+}
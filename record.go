@@ -0,0 +1,178 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	RecordUploaded     = "UPLOADED"
+	RecordDownloaded   = "DOWNLOADED"
+	RecordDeleted      = "DELETED"
+	RecordSkipped      = "SKIPPED"
+	RecordFailed       = "FAILED"
+	RecordTimedOut     = "TIMED OUT"
+	RecordFetched      = "FETCHED"
+	RecordRenamed      = "RENAMED"
+	RecordUpdated      = "UPDATED"
+	RecordVerified     = "VERIFIED"
+	RecordRewritten    = "REWRITTEN"
+	RecordExists       = "EXISTS"
+	RecordNotFound     = "NOT FOUND"
+	RecordDeduplicated = "DEDUPLICATED"
+)
+
+type recordEntryStruct struct {
+	Action     string `json:"action"`
+	Object     string `json:"object"`
+	Status     string `json:"status"`
+	Bytes      int64  `json:"bytes"`
+	CRC32C     string `json:"crc32c,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"`
+	Category   string `json:"category,omitempty"`
+}
+
+var recordFile struct {
+	mutex    sync.Mutex
+	file     *os.File
+	csv      bool
+	rows     int
+	failed   bool
+	inFlight map[string]time.Time
+}
+
+func recordFailedObjects(err error) {
+
+	recordFile.mutex.Lock()
+	objectPaths := make([]string, 0, len(recordFile.inFlight))
+	for objectPath := range recordFile.inFlight {
+		objectPaths = append(objectPaths, objectPath)
+	}
+	startTimes := recordFile.inFlight
+	recordFile.inFlight = nil
+	rows := recordFile.rows
+	recordFile.mutex.Unlock()
+
+	sort.Strings(objectPaths)
+	for _, objectPath := range objectPaths {
+		recordObject(objectPath, RecordFailed, 0, "", time.Since(startTimes[objectPath]), err)
+	}
+	if len(objectPaths) == 0 && rows == 0 {
+		recordObject(appFlag.ObjectPath, RecordFailed, 0, "", time.Since(runMetrics.startTime), err)
+	}
+
+}
+
+func openRecordFile(recordPath string) {
+
+	file, err := os.OpenFile(recordPath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		fatal(classifyError(err), "Cannot open record file! ("+errorDetail(err)+")")
+	}
+
+	recordFile.file = file
+	recordFile.csv = strings.EqualFold(filepath.Ext(recordPath), ".csv")
+
+	if info, err := file.Stat(); recordFile.csv && err == nil && info.Size() == 0 {
+		err = writeRecordCSV([]string{"action", "object", "status", "bytes", "crc32c", "duration_ms", "error", "category"})
+		if err != nil {
+			fatal(classifyError(err), "Cannot write record file! ("+errorDetail(err)+")")
+		}
+	}
+
+}
+
+func writeRecordCSV(fields []string) error {
+
+	csvWriter := csv.NewWriter(recordFile.file)
+	csvWriter.Write(fields)
+	csvWriter.Flush()
+
+	return csvWriter.Error()
+
+}
+
+func trackRecordObject(objectPath string) func() {
+
+	if appFlag.RecordPath == "" {
+		return func() {}
+	}
+
+	recordFile.mutex.Lock()
+	if recordFile.inFlight == nil {
+		recordFile.inFlight = map[string]time.Time{}
+	}
+	recordFile.inFlight[objectPath] = time.Now()
+	recordFile.mutex.Unlock()
+
+	return func() {
+		recordFile.mutex.Lock()
+		delete(recordFile.inFlight, objectPath)
+		recordFile.mutex.Unlock()
+	}
+
+}
+
+func recordObject(objectPath string, status string, bytes int64, crc string, duration time.Duration, err error) {
+
+	if appFlag.RecordPath == "" {
+		return
+	}
+
+	entry := recordEntryStruct{
+		Action:     strings.ToLower(appFlag.ActionType),
+		Object:     objectPath,
+		Status:     status,
+		Bytes:      bytes,
+		CRC32C:     crc,
+		DurationMs: duration.Milliseconds(),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+		entry.Category = classifyError(err)
+	}
+
+	recordFile.mutex.Lock()
+	defer recordFile.mutex.Unlock()
+
+	if recordFile.file == nil {
+		return
+	}
+	recordFile.rows++
+
+	if recordFile.csv {
+		err = writeRecordCSV([]string{entry.Action, entry.Object, entry.Status, strconv.FormatInt(entry.Bytes, 10), entry.CRC32C, strconv.FormatInt(entry.DurationMs, 10), entry.Error, entry.Category})
+	} else {
+		err = json.NewEncoder(recordFile.file).Encode(entry)
+	}
+	if err != nil && !recordFile.failed {
+		recordFile.failed = true
+		LogWarn.Println("WARNING: Cannot write record file! (" + err.Error() + ")")
+	}
+
+}
+
+func closeRecordFile() {
+
+	recordFile.mutex.Lock()
+	defer recordFile.mutex.Unlock()
+
+	if recordFile.file == nil {
+		return
+	}
+
+	err := recordFile.file.Close()
+	recordFile.file = nil
+	if err != nil && !recordFile.failed {
+		LogWarn.Println("WARNING: Cannot write record file! (" + err.Error() + ")")
+	}
+
+}
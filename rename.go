@@ -0,0 +1,79 @@
+package main
+
+import (
+	"os"
+	"strconv"
+
+	"cloud.google.com/go/storage"
+)
+
+func renameObject(storageUnderlyingDataObject *storageUnderlyingDataStruct, bucketName string, objectPath string, destObjectPath string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	bkt := client.Bucket(bucketName)
+	src := bkt.Object(objectPath)
+	dst := bkt.Object(destObjectPath)
+
+	srcAttrs, err := objectAttrs(ctx, src)
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			fatal(classifyError(err), "Object does not exist! ("+errorDetail(err)+")")
+		} else {
+			fatal(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
+		}
+	}
+
+	if !appFlag.AssumeYes {
+		existingAttrs, err := objectAttrs(ctx, dst)
+		if err != nil && err != storage.ErrObjectNotExist {
+			fatal(classifyError(err), "Cannot fetch destination object info! ("+errorDetail(err)+")")
+		}
+		dstExists := err == nil
+
+		if isTerminal(os.Stdin) {
+			question := "Rename object?"
+			if dstExists {
+				question = "Destination object exists, overwrite?"
+			}
+			if !promptConfirm(question) {
+				fatal(ErrCategoryUnknown, "Renaming object aborted by user!")
+			}
+		} else if dstExists {
+			fatal(ErrCategoryPrecondition, "Destination object already exists, set yes as 'true' to override it!")
+		}
+
+		if dstExists {
+			dst = dst.If(storage.Conditions{GenerationMatch: existingAttrs.Generation})
+		} else {
+			dst = dst.If(storage.Conditions{DoesNotExist: true})
+		}
+	}
+
+	dstAttrs, err := dst.CopierFrom(src.Generation(srcAttrs.Generation)).Run(ctx)
+	if err != nil {
+		if isPreconditionFailed(err) {
+			fatal(classifyError(err), "Destination object changed concurrently, rename aborted! ("+errorDetail(err)+")")
+		}
+		fatal(classifyError(err), "Cannot copy object in bucket! ("+errorDetail(err)+")")
+	}
+
+	if dstAttrs.Size != srcAttrs.Size || dstAttrs.CRC32C != srcAttrs.CRC32C {
+		fatal(ErrCategoryUnknown, "Copied object does not match source object, source is kept! (Source's SIZE: "+strconv.FormatInt(srcAttrs.Size, 10)+", CRC32: "+formatCRC32C(srcAttrs.CRC32C)+", Copy's SIZE: "+strconv.FormatInt(dstAttrs.Size, 10)+", CRC32: "+formatCRC32C(dstAttrs.CRC32C)+")")
+	}
+
+	err = src.If(storage.Conditions{GenerationMatch: srcAttrs.Generation}).Delete(ctx)
+	if err != nil {
+		fatal(classifyError(err), "Cannot delete source object after copy, both objects exist now! ("+errorDetail(err)+")")
+	}
+
+	recordObject(destObjectPath, RecordRenamed, dstAttrs.Size, formatCRC32C(dstAttrs.CRC32C), 0, nil)
+
+	LogInfo.Println("SUCCESS: Object renamed in GCP Bucket. (Renamed Object's SIZE: " + strconv.FormatInt(dstAttrs.Size, 10) + ", CRC32: " + formatCRC32C(dstAttrs.CRC32C) + ", GENERATION: " + strconv.FormatInt(dstAttrs.Generation, 10) + ")")
+
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"sync/atomic"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
+)
+
+var retryCount atomic.Int64
+
+func retryCodeAllowed(code int) bool {
+
+	return len(appFlag.RetryCodes) == 0 || slices.Contains(appFlag.RetryCodes, code)
+
+}
+
+func shouldRetryError(err error) bool {
+
+	var apiErr *googleapi.Error
+	if len(appFlag.RetryCodes) > 0 && errors.As(err, &apiErr) {
+		return retryCodeAllowed(apiErr.Code)
+	}
+
+	return storage.ShouldRetry(err)
+
+}
+
+func retryErrorFunc(maxRetries int64, retryCount *atomic.Int64) func(err error) bool {
+
+	return func(err error) bool {
+		if !shouldRetryError(err) {
+			return false
+		}
+
+		count := retryCount.Add(1)
+		if count > maxRetries {
+			LogWarn.Println("WARNING: Retry budget exhausted, giving up! (Max Retries: " + strconv.FormatInt(maxRetries, 10) + ")")
+			return false
+		}
+
+		LogDebug.Println("DEBUG: Retrying failed request. (Retry: " + strconv.FormatInt(count, 10) + ", " + errorDetail(err) + ")")
+		return true
+	}
+
+}
+
+func preflightRetryOptions() []storage.RetryOption {
+
+	return []storage.RetryOption{
+		storage.WithPolicy(storage.RetryAlways),
+		storage.WithErrorFunc(retryErrorFunc(int64(appFlag.PreflightRetries), new(atomic.Int64))),
+	}
+
+}
+
+func preflightBucket(bkt *storage.BucketHandle) *storage.BucketHandle {
+
+	if appFlag.PreflightRetries == 0 {
+		return bkt
+	}
+
+	return bkt.Retryer(preflightRetryOptions()...)
+
+}
+
+func preflightObject(obj *storage.ObjectHandle) *storage.ObjectHandle {
+
+	if appFlag.PreflightRetries == 0 {
+		return obj
+	}
+
+	return obj.Retryer(preflightRetryOptions()...)
+
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+)
+
+const (
+	ActionAttemptEnv     = "GCP_BUCKET_LOADER_ACTION_ATTEMPT"
+	actionRetryBaseDelay = time.Second
+	actionRetryMaxDelay  = 30 * time.Second
+)
+
+func isActionAttempt() bool {
+
+	return os.Getenv(ActionAttemptEnv) != ""
+
+}
+
+func actionWillRetry(exitCode int) bool {
+
+	attempt, err := strconv.Atoi(os.Getenv(ActionAttemptEnv))
+	if err != nil || exitCode == ExitCodeAlreadyExists || exitCode == ExitCodeOverwritten {
+		return false
+	}
+
+	return attempt <= int(appFlag.RetryAction)
+
+}
+
+func runActionWithRetries(maxRetries int) {
+
+	executable, err := os.Executable()
+	if err != nil {
+		fatal(classifyError(err), "Cannot find own executable to retry action! ("+errorDetail(err)+")")
+	}
+
+	delay := actionRetryBaseDelay
+	for attempt := 1; ; attempt++ {
+		cmd := exec.Command(executable, os.Args[1:]...)
+		cmd.Env = append(os.Environ(), ActionAttemptEnv+"="+strconv.Itoa(attempt))
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+
+		LogInfo.Println("INFO: Running action. (Attempt: " + strconv.Itoa(attempt) + ", Max Attempts: " + strconv.Itoa(maxRetries+1) + ")")
+		err = cmd.Run()
+		if err == nil {
+			os.Exit(0)
+		}
+
+		exitCode := ExitCodeError
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			exitCode = exitErr.ExitCode()
+		}
+		if exitCode == ExitCodeAlreadyExists || exitCode == ExitCodeOverwritten {
+			os.Exit(exitCode)
+		}
+		if attempt > maxRetries {
+			LogWarn.Println("WARNING: Action failed after all attempts, giving up! (Attempts: " + strconv.Itoa(attempt) + ", Exit Code: " + strconv.Itoa(exitCode) + ")")
+			os.Exit(exitCode)
+		}
+
+		LogWarn.Println("WARNING: Action failed, going to retry from scratch! (Attempt: " + strconv.Itoa(attempt) + ", Delay: " + delay.String() + ")")
+		time.Sleep(delay)
+		delay = min(delay*2, actionRetryMaxDelay)
+	}
+
+}
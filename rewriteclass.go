@@ -0,0 +1,58 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/storage"
+)
+
+func rewriteObjectClass(storageUnderlyingDataObject *storageUnderlyingDataStruct, bucketName string, objectPath string, storageClass string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	storageClass = strings.ToUpper(storageClass)
+	obj := client.Bucket(bucketName).Object(objectPath)
+
+	objAttrs, err := objectAttrs(ctx, obj)
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			fatal(classifyError(err), "Object does not exist! ("+errorDetail(err)+")")
+		} else {
+			fatal(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
+		}
+	}
+
+	if objAttrs.StorageClass == storageClass {
+		recordObject(objectPath, RecordSkipped, 0, formatCRC32C(objAttrs.CRC32C), 0, nil)
+		LogInfo.Println("SKIPPED: Object already has requested storage class, rewrite skipped. (Object's CLASS: " + objAttrs.StorageClass + ")")
+		return
+	}
+
+	copier := obj.If(storage.Conditions{GenerationMatch: objAttrs.Generation}).CopierFrom(obj.Generation(objAttrs.Generation))
+	copier.StorageClass = storageClass
+	copier.ProgressFunc = func(copiedBytes uint64, totalBytes uint64) {
+		LogDebug.Println("DEBUG: Rewrite continued with token. (Copied Bytes: " + strconv.FormatUint(copiedBytes, 10) + ", Total Bytes: " + strconv.FormatUint(totalBytes, 10) + ")")
+	}
+
+	newAttrs, err := copier.Run(ctx)
+	if err != nil {
+		if isPreconditionFailed(err) {
+			fatal(classifyError(err), "Object changed during rewrite, storage class is not changed! ("+errorDetail(err)+")")
+		}
+		if copier.RewriteToken != "" {
+			fatal(classifyError(err), "Cannot rewrite object in bucket, rewrite was interrupted! ("+errorDetail(err)+")")
+		}
+		fatal(classifyError(err), "Cannot rewrite object in bucket! ("+errorDetail(err)+")")
+	}
+
+	recordObject(objectPath, RecordRewritten, newAttrs.Size, formatCRC32C(newAttrs.CRC32C), 0, nil)
+
+	LogInfo.Println("SUCCESS: Object storage class changed in GCP Bucket. (Object's PREVIOUS CLASS: " + objAttrs.StorageClass + ", CLASS: " + newAttrs.StorageClass + ", GENERATION: " + strconv.FormatInt(newAttrs.Generation, 10) + ")")
+
+}
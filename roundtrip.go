@@ -0,0 +1,80 @@
+package main
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+
+	"cloud.google.com/go/storage"
+)
+
+func plainSHA256(reader io.Reader) (string, error) {
+
+	hash := sha256.New()
+	_, err := io.Copy(hash, reader)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+
+}
+
+func gunzipSHA256(reader io.Reader) (string, error) {
+
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return "", err
+	}
+	defer gzipReader.Close()
+
+	return plainSHA256(gzipReader)
+
+}
+
+func verifyRoundTrip(ctx context.Context, obj *storage.ObjectHandle, objAttrs *storage.ObjectAttrs, filePath string) error {
+
+	if objAttrs.ContentEncoding != "gzip" {
+		LogWarn.Println("WARNING: Object is not gzip encoded, round trip verification is skipped!")
+		return nil
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return newCategorizedError(classifyError(err), "Cannot open requested file for round trip verification! ("+errorDetail(err)+")")
+	}
+	defer file.Close()
+
+	var localSum string
+	if appFlag.Gzip {
+		localSum, err = plainSHA256(file)
+	} else {
+		localSum, err = gunzipSHA256(file)
+	}
+	if err != nil {
+		return newCategorizedError(classifyError(err), "Cannot hash requested file for round trip verification! ("+errorDetail(err)+")")
+	}
+
+	reader, err := openObjectReader(ctx, obj.Generation(objAttrs.Generation).ReadCompressed(true), 0, -1)
+	if err != nil {
+		return newCategorizedError(classifyError(err), "Cannot create new reader for round trip verification! ("+errorDetail(err)+")")
+	}
+	defer reader.Close()
+
+	remoteSum, err := gunzipSHA256(reader)
+	if err != nil {
+		return newCategorizedError(classifyError(err), "Cannot decompress uploaded object for round trip verification! ("+errorDetail(err)+")")
+	}
+
+	if remoteSum != localSum {
+		return newCategorizedError(ErrCategoryIO, "Round trip verification failed, decompressed object does not match requested file! (Object's SHA256: "+remoteSum+", Local File's SHA256: "+localSum+")")
+	}
+
+	LogInfo.Println("INFO: Round trip verification passed. (Decompressed SHA256: " + localSum + ")")
+
+	return nil
+
+}
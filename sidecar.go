@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+const MetaSuffix = ".meta.json"
+
+type objectMetaStruct struct {
+	Bucket          string            `json:"bucket"`
+	Name            string            `json:"name"`
+	Size            int64             `json:"size"`
+	CRC32C          uint32            `json:"crc32c"`
+	MD5             string            `json:"md5"`
+	ContentType     string            `json:"content_type"`
+	ContentEncoding string            `json:"content_encoding,omitempty"`
+	CacheControl    string            `json:"cache_control,omitempty"`
+	StorageClass    string            `json:"storage_class"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
+	Generation      int64             `json:"generation"`
+	Metageneration  int64             `json:"metageneration"`
+	Created         string            `json:"created"`
+	Updated         string            `json:"updated"`
+}
+
+func objectMetaFromAttrs(objAttrs *storage.ObjectAttrs, decompressed bool) objectMetaStruct {
+
+	objectMeta := objectMetaStruct{
+		Bucket:          objAttrs.Bucket,
+		Name:            objAttrs.Name,
+		Size:            objAttrs.Size,
+		CRC32C:          objAttrs.CRC32C,
+		MD5:             hex.EncodeToString(objAttrs.MD5),
+		ContentType:     objAttrs.ContentType,
+		ContentEncoding: objAttrs.ContentEncoding,
+		CacheControl:    objAttrs.CacheControl,
+		StorageClass:    objAttrs.StorageClass,
+		Metadata:        objAttrs.Metadata,
+		Generation:      objAttrs.Generation,
+		Metageneration:  objAttrs.Metageneration,
+		Created:         objAttrs.Created.UTC().Format(time.RFC3339),
+		Updated:         objAttrs.Updated.UTC().Format(time.RFC3339),
+	}
+
+	if decompressed {
+		LogDebug.Println("DEBUG: Content encoding is not written to meta file, since object was decompressed on download. (Content Encoding: " + objAttrs.ContentEncoding + ")")
+		objectMeta.ContentEncoding = ""
+	}
+
+	return objectMeta
+
+}
+
+func writeMetaSidecar(ctx context.Context, obj *storage.ObjectHandle, filePath string, decompressed bool) {
+
+	objAttrs, err := objectAttrs(ctx, obj)
+	if err != nil {
+		fatal(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
+	}
+
+	content, err := json.MarshalIndent(objectMetaFromAttrs(objAttrs, decompressed), "", "  ")
+	if err != nil {
+		fatal(classifyError(err), "Cannot encode object info! ("+errorDetail(err)+")")
+	}
+
+	metaPath := filePath + MetaSuffix
+	err = os.WriteFile(metaPath, append(content, '\n'), 0644)
+	if err != nil {
+		fatal(classifyError(err), "Cannot write requested meta file! ("+errorDetail(err)+")")
+	}
+
+	LogInfo.Println("INFO: Object info written to meta file. (Meta File: " + metaPath + ")")
+
+}
+
+func readMetaSidecar(filePath string) (objectMetaStruct, bool, error) {
+
+	var objectMeta objectMetaStruct
+
+	metaPath := filePath + MetaSuffix
+	content, err := os.ReadFile(metaPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			LogWarn.Println("WARNING: Meta file does not exist, going to upload without it! (Meta File: " + metaPath + ")")
+			return objectMeta, false, nil
+		}
+		return objectMeta, false, newCategorizedError(classifyError(err), "Cannot read requested meta file! ("+errorDetail(err)+")")
+	}
+
+	err = json.Unmarshal(content, &objectMeta)
+	if err != nil {
+		return objectMeta, false, newCategorizedError(classifyError(err), "Cannot parse requested meta file! ("+errorDetail(err)+")")
+	}
+
+	LogDebug.Println("DEBUG: Object info read from meta file. (Meta File: " + metaPath + ")")
+
+	return objectMeta, true, nil
+
+}
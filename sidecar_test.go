@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cloud.google.com/go/storage"
+)
+
+func TestMetaSidecarContentEncoding(t *testing.T) {
+
+	objAttrs := &storage.ObjectAttrs{
+		Bucket:          "bucket",
+		Name:            "dir/file.txt",
+		ContentType:     "text/plain",
+		ContentEncoding: "gzip",
+	}
+
+	tests := []struct {
+		decompressed bool
+		want         string
+	}{
+		{true, ""},
+		{false, "gzip"},
+	}
+
+	for _, test := range tests {
+		filePath := filepath.Join(t.TempDir(), "file.txt")
+
+		content, err := json.Marshal(objectMetaFromAttrs(objAttrs, test.decompressed))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filePath+MetaSuffix, content, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		objectMeta, hasObjectMeta, err := readMetaSidecar(filePath)
+		if err != nil || !hasObjectMeta {
+			t.Fatalf("readMetaSidecar() = %v, %v, want meta file read", hasObjectMeta, err)
+		}
+		if objectMeta.ContentEncoding != test.want {
+			t.Errorf("decompressed %v: content encoding read back = %q, want %q", test.decompressed, objectMeta.ContentEncoding, test.want)
+		}
+	}
+
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+const (
+	defaultSignExpiry = 15 * time.Minute
+	maxSignExpiry     = 7 * 24 * time.Hour
+)
+
+type signedPolicyStruct struct {
+	URL     string            `json:"url"`
+	Method  string            `json:"method"`
+	Headers map[string]string `json:"headers"`
+	Expires string            `json:"expires"`
+}
+
+func signUploadPolicy(storageUnderlyingDataObject *storageUnderlyingDataStruct, bucketName string, objectPath string, contentType string) {
+
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	expiry := appFlag.Expiry
+	if expiry <= 0 {
+		expiry = defaultSignExpiry
+	} else if expiry > maxSignExpiry {
+		fatal(ErrCategoryUnknown, "Expiry parameter cannot be longer than 7 days for V4 signing!")
+	}
+	expires := time.Now().Add(expiry)
+
+	headers := map[string]string{}
+	var signedHeaders []string
+	if contentType != "" {
+		headers["Content-Type"] = contentType
+	}
+	if appFlag.MaxSize > 0 {
+		headers["x-goog-content-length-range"] = "0," + strconv.FormatUint(appFlag.MaxSize, 10)
+		signedHeaders = append(signedHeaders, "x-goog-content-length-range:"+headers["x-goog-content-length-range"])
+	}
+
+	signedURLOptions := &storage.SignedURLOptions{
+		Scheme:      storage.SigningSchemeV4,
+		Method:      http.MethodPut,
+		ContentType: contentType,
+		Headers:     signedHeaders,
+		Expires:     expires,
+	}
+
+	if appFlag.KeyPath != "" && appFlag.AccessToken == "" {
+		keyFile, err := readKeyFile(appFlag.KeyPath)
+		if err != nil {
+			fatal(classifyError(err), "Cannot read requested key file! ("+errorDetail(err)+")")
+		}
+		if keyFile.ClientEmail != "" && keyFile.PrivateKey != "" {
+			signedURLOptions.GoogleAccessID = keyFile.ClientEmail
+			signedURLOptions.PrivateKey = []byte(keyFile.PrivateKey)
+		}
+	}
+
+	signedURL, err := client.Bucket(bucketName).SignedURL(objectPath, signedURLOptions)
+	if err != nil {
+		fatal(classifyError(err), "Cannot sign upload policy! ("+errorDetail(err)+")")
+	}
+
+	printJSON(signedPolicyStruct{
+		URL:     signedURL,
+		Method:  http.MethodPut,
+		Headers: headers,
+		Expires: expires.UTC().Format(time.RFC3339),
+	})
+
+	LogInfo.Println("SUCCESS: Upload policy signed for GCP Bucket. (Expires: " + expires.UTC().Format(time.RFC3339) + ")")
+
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
+	raw "google.golang.org/api/storage/v1"
+)
+
+type softDeletedObjectStruct struct {
+	Name           string `json:"name"`
+	Size           uint64 `json:"size"`
+	Generation     int64  `json:"generation"`
+	SoftDeleteTime string `json:"soft_delete_time"`
+	HardDeleteTime string `json:"hard_delete_time"`
+}
+
+func createRawService(ctx context.Context) *raw.Service {
+
+	service, err := raw.NewService(ctx, createClientOptions(ctx, appFlag.PublicRequest, appFlag.KeyPath)...)
+	if err != nil {
+		fatal(classifyError(err), "Cannot create new storage service! ("+errorDetail(err)+")")
+	}
+
+	return service
+
+}
+
+func listSoftDeletedObjects(ctx context.Context, bucketName string, prefix string, outputFormat string, csvWriter *csv.Writer) {
+
+	service := createRawService(ctx)
+
+	var objectCount int64
+
+	err := service.Objects.List(bucketName).Prefix(prefix).SoftDeleted(true).Pages(ctx, func(objects *raw.Objects) error {
+		for _, object := range objects.Items {
+			objectCount++
+
+			switch outputFormat {
+			case OutputText:
+				fmt.Println("OBJECT: " + object.Name + " (SIZE: " + strconv.FormatUint(object.Size, 10) + ", GENERATION: " + strconv.FormatInt(object.Generation, 10) + ", SOFT DELETED: " + object.SoftDeleteTime + ", HARD DELETE: " + object.HardDeleteTime + ")")
+			case OutputJSON:
+				printJSON(softDeletedObjectStruct{
+					Name:           object.Name,
+					Size:           object.Size,
+					Generation:     object.Generation,
+					SoftDeleteTime: object.SoftDeleteTime,
+					HardDeleteTime: object.HardDeleteTime,
+				})
+			case OutputCSV:
+				csvWriter.Write([]string{object.Name, strconv.FormatUint(object.Size, 10), strconv.FormatInt(object.Generation, 10), object.SoftDeleteTime, object.HardDeleteTime})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		fatal(classifyError(err), "Cannot list soft-deleted objects! ("+errorDetail(err)+")")
+	}
+
+	if csvWriter != nil {
+		csvWriter.Flush()
+		err := csvWriter.Error()
+		if err != nil {
+			fatal(classifyError(err), "Cannot write CSV output! ("+errorDetail(err)+")")
+		}
+	}
+
+	LogInfo.Println("SUCCESS: Soft-deleted objects listed from GCP Bucket. (Listed Objects: " + strconv.FormatInt(objectCount, 10) + ")")
+
+}
+
+func findSoftDeletedObject(ctx context.Context, service *raw.Service, bucketName string, objectPath string, generation int64) *raw.Object {
+
+	if generation > 0 {
+		object, err := service.Objects.Get(bucketName, objectPath).Generation(generation).SoftDeleted(true).Context(ctx).Do()
+		if err != nil {
+			fatal(classifyError(err), "Cannot fetch soft-deleted object info! ("+errorDetail(err)+")")
+		}
+		return object
+	}
+
+	var latest *raw.Object
+	err := service.Objects.List(bucketName).Prefix(objectPath).SoftDeleted(true).Pages(ctx, func(objects *raw.Objects) error {
+		for _, object := range objects.Items {
+			if object.Name == objectPath && (latest == nil || object.Generation > latest.Generation) {
+				latest = object
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		fatal(classifyError(err), "Cannot list soft-deleted objects! ("+errorDetail(err)+")")
+	}
+	if latest == nil {
+		fatal(ErrCategoryNotFound, "Soft-deleted object does not exist!")
+	}
+
+	return latest
+
+}
+
+func softDeletedObjectAttrs(object *raw.Object) *storage.ObjectAttrs {
+
+	updated, _ := time.Parse(time.RFC3339, object.Updated)
+
+	return &storage.ObjectAttrs{Name: object.Name, Size: int64(object.Size), Generation: object.Generation, Updated: updated}
+
+}
+
+func restoreSoftDeletedObject(ctx context.Context, service *raw.Service, object *raw.Object) {
+
+	restored, err := service.Objects.Restore(object.Bucket, object.Name, nil).Context(ctx).Do(googleapi.QueryParameter("generation", strconv.FormatInt(object.Generation, 10)))
+	if err != nil {
+		fatal(classifyError(err), "Cannot restore soft-deleted object! ("+errorDetail(err)+")")
+	}
+
+	LogInfo.Println("INFO: Soft-deleted object restored. (Restored Generation: " + strconv.FormatInt(object.Generation, 10) + ", New Generation: " + strconv.FormatInt(restored.Generation, 10) + ")")
+
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+)
+
+type uploadSpecStruct struct {
+	ContentType     string            `json:"content_type"`
+	CacheControl    string            `json:"cache_control"`
+	ContentEncoding string            `json:"content_encoding"`
+	StorageClass    string            `json:"storage_class"`
+	Metadata        map[string]string `json:"metadata"`
+	Grants          []string          `json:"grants"`
+}
+
+var uploadSpecFields = map[string]bool{
+	"content_type":     true,
+	"cache_control":    true,
+	"content_encoding": true,
+	"storage_class":    true,
+	"metadata":         true,
+	"grants":           true,
+}
+
+var uploadSpec uploadSpecStruct
+
+func readUploadSpec(specPath string) uploadSpecStruct {
+
+	content, err := os.ReadFile(specPath)
+	if err != nil {
+		fatal(classifyError(err), "Cannot read requested spec file! ("+errorDetail(err)+")")
+	}
+
+	var spec uploadSpecStruct
+	err = json.Unmarshal(content, &spec)
+	if err != nil {
+		fatal(classifyError(err), "Cannot parse requested spec file! ("+errorDetail(err)+")")
+	}
+
+	var fields map[string]json.RawMessage
+	json.Unmarshal(content, &fields)
+
+	var unknownFields []string
+	for field := range fields {
+		if !uploadSpecFields[field] {
+			unknownFields = append(unknownFields, field)
+		}
+	}
+	sort.Strings(unknownFields)
+	for _, field := range unknownFields {
+		LogWarn.Println("WARNING: Unknown field in spec file is discarded! (Field: " + field + ")")
+	}
+
+	return spec
+
+}
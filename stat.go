@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+type objectStatStruct struct {
+	Bucket         string `json:"bucket"`
+	Name           string `json:"name"`
+	ContentType    string `json:"content_type"`
+	StorageClass   string `json:"storage_class"`
+	Size           int64  `json:"size"`
+	CRC32C         uint32 `json:"crc32c"`
+	MD5            string `json:"md5"`
+	Generation     int64  `json:"generation"`
+	Metageneration int64  `json:"metageneration"`
+	Created        string `json:"created"`
+	Updated        string `json:"updated"`
+}
+
+func newObjectStat(objAttrs *storage.ObjectAttrs) *objectStatStruct {
+
+	return &objectStatStruct{
+		Bucket:         objAttrs.Bucket,
+		Name:           objAttrs.Name,
+		ContentType:    objAttrs.ContentType,
+		StorageClass:   objAttrs.StorageClass,
+		Size:           objAttrs.Size,
+		CRC32C:         objAttrs.CRC32C,
+		MD5:            hex.EncodeToString(objAttrs.MD5),
+		Generation:     objAttrs.Generation,
+		Metageneration: objAttrs.Metageneration,
+		Created:        objAttrs.Created.UTC().Format(time.RFC3339),
+		Updated:        objAttrs.Updated.UTC().Format(time.RFC3339),
+	}
+
+}
+
+func printJSON(value any) {
+
+	var output []byte
+	var err error
+	if appFlag.PrettyJSON {
+		output, err = json.MarshalIndent(value, "", "  ")
+	} else {
+		output, err = json.Marshal(value)
+	}
+	if err != nil {
+		fatal(classifyError(err), "Cannot encode JSON output! ("+errorDetail(err)+")")
+	}
+
+	fmt.Println(string(output))
+
+}
+
+func statObject(storageUnderlyingDataObject *storageUnderlyingDataStruct, bucketName string, objectPath string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	obj := client.Bucket(bucketName).Object(objectPath)
+	if appFlag.Generation > 0 {
+		obj = obj.Generation(appFlag.Generation)
+	}
+
+	objAttrs, err := objectAttrs(ctx, obj)
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			fatal(classifyError(err), "Object does not exist! ("+errorDetail(err)+")")
+		} else {
+			fatal(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
+		}
+	}
+
+	objStat := newObjectStat(objAttrs)
+	recordObject(objAttrs.Name, RecordFetched, objAttrs.Size, formatCRC32C(objAttrs.CRC32C), 0, nil)
+
+	if appFlag.JSONOutput {
+		printJSON(objStat)
+	}
+
+	LogInfo.Println("SUCCESS: Object info fetched from GCP Bucket. (Object's SIZE: " + strconv.FormatInt(objStat.Size, 10) + ", CRC32: " + formatCRC32C(objStat.CRC32C) + ", GENERATION: " + strconv.FormatInt(objStat.Generation, 10) + ", METAGENERATION: " + strconv.FormatInt(objStat.Metageneration, 10) + ", CREATED: " + objStat.Created + ", UPDATED: " + objStat.Updated + ")")
+
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+type summaryRowStruct struct {
+	name     string
+	status   string
+	bytes    int64
+	duration time.Duration
+}
+
+type summaryTable struct {
+	mutex sync.Mutex
+	rows  []summaryRowStruct
+}
+
+func (t *summaryTable) add(name string, status string, bytes int64, duration time.Duration) {
+
+	if !appFlag.SummaryTable {
+		return
+	}
+
+	t.mutex.Lock()
+	t.rows = append(t.rows, summaryRowStruct{name: name, status: status, bytes: bytes, duration: duration})
+	t.mutex.Unlock()
+
+}
+
+func (t *summaryTable) print() {
+
+	if !appFlag.SummaryTable {
+		return
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	var totalBytes int64
+	var totalDuration time.Duration
+
+	tabWriter := tabwriter.NewWriter(os.Stderr, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tabWriter, "OBJECT\tSTATUS\tBYTES\tDURATION\t")
+	for _, row := range t.rows {
+		totalBytes += row.bytes
+		totalDuration += row.duration
+		fmt.Fprintln(tabWriter, row.name+"\t"+row.status+"\t"+strconv.FormatInt(row.bytes, 10)+"\t"+row.duration.Round(time.Millisecond).String()+"\t")
+	}
+	fmt.Fprintln(tabWriter, "TOTAL ("+strconv.Itoa(len(t.rows))+")\t\t"+strconv.FormatInt(totalBytes, 10)+"\t"+totalDuration.Round(time.Millisecond).String()+"\t")
+	tabWriter.Flush()
+
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"strconv"
+	"time"
+)
+
+type throughputStruct struct {
+	Bytes          int64   `json:"bytes"`
+	ElapsedSeconds float64 `json:"elapsed_seconds"`
+	ThroughputMBps float64 `json:"throughput_mbps"`
+}
+
+type batchThroughputStruct struct {
+	Files int64 `json:"files"`
+	throughputStruct
+}
+
+func newThroughput(bytes int64, elapsed time.Duration) throughputStruct {
+
+	report := throughputStruct{Bytes: bytes, ElapsedSeconds: elapsed.Seconds()}
+	if elapsed > 0 {
+		report.ThroughputMBps = float64(bytes) / 1e6 / elapsed.Seconds()
+	}
+
+	return report
+
+}
+
+func reportThroughput(bytes int64, elapsed time.Duration) {
+
+	report := newThroughput(bytes, elapsed)
+
+	if appFlag.JSONOutput {
+		printJSON(report)
+	}
+
+	LogInfo.Println("INFO: Upload throughput measured. (Bytes: " + strconv.FormatInt(report.Bytes, 10) + ", Elapsed: " + strconv.FormatFloat(report.ElapsedSeconds, 'f', 3, 64) + "s, Throughput: " + strconv.FormatFloat(report.ThroughputMBps, 'f', 2, 64) + " MB/s)")
+
+}
+
+func reportBatchThroughput(files int64, bytes int64, elapsed time.Duration) {
+
+	report := batchThroughputStruct{Files: files, throughputStruct: newThroughput(bytes, elapsed)}
+
+	if appFlag.JSONOutput {
+		printJSON(report)
+	}
+
+	LogInfo.Println("INFO: Batch upload throughput measured. (Files: " + strconv.FormatInt(report.Files, 10) + ", Bytes: " + strconv.FormatInt(report.Bytes, 10) + ", Elapsed: " + strconv.FormatFloat(report.ElapsedSeconds, 'f', 3, 64) + "s, Throughput: " + strconv.FormatFloat(report.ThroughputMBps, 'f', 2, 64) + " MB/s)")
+
+}
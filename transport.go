@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+)
+
+const (
+	defaultDialTimeout = 30 * time.Second
+	defaultKeepAlive   = 30 * time.Second
+)
+
+const (
+	invocationIDPrefix = "gccl-invocation-id/"
+	attemptCountPrefix = "gccl-attempt-count/"
+)
+
+type retryLoggingTransport struct {
+	base http.RoundTripper
+}
+
+func newRetryLoggingTransport(base http.RoundTripper) *retryLoggingTransport {
+
+	return &retryLoggingTransport{base: base}
+
+}
+
+func requestAttempt(req *http.Request) (string, int) {
+
+	invocationID := ""
+	attempt := 1
+	for _, field := range strings.Fields(req.Header.Get("X-Goog-Api-Client")) {
+		if strings.HasPrefix(field, invocationIDPrefix) {
+			invocationID = strings.TrimPrefix(field, invocationIDPrefix)
+		} else if strings.HasPrefix(field, attemptCountPrefix) {
+			count, err := strconv.Atoi(strings.TrimPrefix(field, attemptCountPrefix))
+			if err == nil && count > 0 {
+				attempt = count
+			}
+		}
+	}
+
+	return invocationID, attempt
+
+}
+
+func (t *retryLoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	invocationID, attempt := requestAttempt(req)
+
+	if attempt > 1 {
+		LogDebug.Println("DEBUG: Retrying request to GCP. (Attempt: " + strconv.Itoa(attempt) + ", Invocation: " + invocationID + ", Method: " + req.Method + ", Path: " + req.URL.Path + ")")
+	}
+
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		LogDebug.Println("DEBUG: Request to GCP failed. (Attempt: " + strconv.Itoa(attempt) + ", Reason: " + err.Error() + ")")
+	} else if res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError {
+		LogDebug.Println("DEBUG: Request to GCP failed with retryable status. (Attempt: " + strconv.Itoa(attempt) + ", Status: " + strconv.Itoa(res.StatusCode) + ")")
+	}
+
+	return res, err
+
+}
+
+type throttleTransport struct {
+	base       http.RoundTripper
+	maxRetries int64
+}
+
+func (t *throttleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	for {
+		res, err := t.base.RoundTrip(req)
+		if err != nil || res.StatusCode != http.StatusTooManyRequests || !retryCodeAllowed(res.StatusCode) {
+			return res, err
+		}
+
+		wait, ok := retryAfterDuration(res.Header.Get("Retry-After"))
+		if !ok || (req.Body != nil && req.GetBody == nil) {
+			return res, err
+		}
+
+		count := retryCount.Add(1)
+		if count > t.maxRetries {
+			LogWarn.Println("WARNING: Retry budget exhausted while throttled, giving up! (Max Retries: " + strconv.FormatInt(t.maxRetries, 10) + ")")
+			return res, err
+		}
+
+		LogWarn.Println("WARNING: Request throttled by GCP, going to retry after requested delay! (Retry: " + strconv.FormatInt(count, 10) + ", Delay: " + wait.String() + ", Path: " + req.URL.Path + ")")
+
+		io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		req = req.Clone(req.Context())
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+}
+
+func retryAfterDuration(value string) (time.Duration, bool) {
+
+	if value == "" {
+		return 0, false
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	wait := time.Until(date)
+	if wait < 0 {
+		wait = 0
+	}
+
+	return wait, true
+
+}
+
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+func createTLSConfig() (*tls.Config, error) {
+
+	tlsConfig := &tls.Config{}
+
+	if appFlag.TLSMinVersion != "" {
+		version, ok := tlsVersions[appFlag.TLSMinVersion]
+		if !ok {
+			return nil, errors.New("unsupported TLS version " + appFlag.TLSMinVersion + ", must be 1.2 or 1.3")
+		}
+		tlsConfig.MinVersion = version
+	}
+
+	if appFlag.CACertPath != "" {
+		content, err := os.ReadFile(appFlag.CACertPath)
+		if err != nil {
+			return nil, err
+		}
+		certPool, err := x509.SystemCertPool()
+		if err != nil {
+			certPool = x509.NewCertPool()
+		}
+		if !certPool.AppendCertsFromPEM(content) {
+			return nil, errors.New("no PEM certificate found in " + appFlag.CACertPath)
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	return tlsConfig, nil
+
+}
+
+func baseTransport() (http.RoundTripper, error) {
+
+	if appFlag.TLSMinVersion == "" && appFlag.CACertPath == "" && appFlag.IdleConnTimeout == 0 && appFlag.KeepAlive == 0 {
+		return http.DefaultTransport, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if appFlag.TLSMinVersion != "" || appFlag.CACertPath != "" {
+		tlsConfig, err := createTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	if appFlag.IdleConnTimeout != 0 || appFlag.KeepAlive != 0 {
+		if appFlag.IdleConnTimeout != 0 {
+			transport.IdleConnTimeout = appFlag.IdleConnTimeout
+		}
+		keepAlive := defaultKeepAlive
+		if appFlag.KeepAlive != 0 {
+			keepAlive = appFlag.KeepAlive
+		}
+		transport.DialContext = (&net.Dialer{Timeout: defaultDialTimeout, KeepAlive: keepAlive}).DialContext
+
+		concurrency := int(appFlag.Concurrency)
+		if concurrency <= 0 {
+			concurrency = defaultConcurrency
+		}
+		transport.MaxIdleConnsPerHost = max(concurrency, http.DefaultMaxIdleConnsPerHost)
+
+		LogDebug.Println("DEBUG: Transport connection reuse tuned. (Idle Conn Timeout: " + transport.IdleConnTimeout.String() + ", Keep Alive: " + keepAlive.String() + ", Max Idle Conns Per Host: " + strconv.Itoa(transport.MaxIdleConnsPerHost) + ")")
+	}
+
+	return transport, nil
+
+}
+
+func createHTTPClient(ctx context.Context, clientOption option.ClientOption) *http.Client {
+
+	tunedTransport, err := baseTransport()
+	if err != nil {
+		fatal(classifyError(err), "Cannot create TLS config! ("+errorDetail(err)+")")
+	}
+
+	var base http.RoundTripper = newRetryLoggingTransport(tunedTransport)
+	if appFlag.MaxRetries > 0 {
+		base = &throttleTransport{base: base, maxRetries: int64(appFlag.MaxRetries)}
+	}
+
+	transport, err := htransport.NewTransport(ctx, base, clientOption, option.WithScopes(storage.ScopeFullControl, "https://www.googleapis.com/auth/cloud-platform"))
+	if err != nil {
+		fatal(classifyError(err), "Cannot create new transport! ("+errorDetail(err)+")")
+	}
+
+	return &http.Client{Transport: transport}
+
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+const (
+	benchmarkBucketEnv    = "GCP_BUCKET_LOADER_BENCH_BUCKET"
+	benchmarkKeyEnv       = "GCP_BUCKET_LOADER_BENCH_KEY"
+	benchmarkTransferSize = 8 * 1024 * 1024
+)
+
+func BenchmarkTransportThroughput(b *testing.B) {
+
+	bucketName := os.Getenv(benchmarkBucketEnv)
+	keyPath := os.Getenv(benchmarkKeyEnv)
+	if bucketName == "" || keyPath == "" {
+		b.Skip("set " + benchmarkBucketEnv + " and " + benchmarkKeyEnv + " to benchmark transports against a real bucket")
+	}
+
+	appFlag = &AppFlagStruct{KeyPath: keyPath}
+	content := bytes.Repeat([]byte{'x'}, benchmarkTransferSize)
+
+	transports := []struct {
+		name      string
+		newClient func(ctx context.Context) (*storage.Client, error)
+	}{
+		{"http", func(ctx context.Context) (*storage.Client, error) {
+			return storage.NewClient(ctx, createClientOptions(ctx, false, keyPath)...)
+		}},
+		{"grpc", func(ctx context.Context) (*storage.Client, error) {
+			return storage.NewGRPCClient(ctx, createAuthOption(false, keyPath))
+		}},
+	}
+
+	for _, transport := range transports {
+		ctx := context.Background()
+		client, err := transport.newClient(ctx)
+		if err != nil {
+			b.Fatal(err)
+		}
+		obj := client.Bucket(bucketName).Object("gcp-bucket-loader-bench/" + transport.name + "-" + strconv.FormatInt(time.Now().UnixNano(), 10))
+
+		b.Run(transport.name+"/upload", func(b *testing.B) {
+			b.SetBytes(benchmarkTransferSize)
+			for i := 0; i < b.N; i++ {
+				writer := obj.NewWriter(ctx)
+				_, err := copyBuffered(writer, bytes.NewReader(content))
+				if err == nil {
+					err = writer.Close()
+				}
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		b.Run(transport.name+"/download", func(b *testing.B) {
+			b.SetBytes(benchmarkTransferSize)
+			for i := 0; i < b.N; i++ {
+				reader, err := obj.NewReader(ctx)
+				if err != nil {
+					b.Fatal(err)
+				}
+				_, err = copyDownload(io.Discard, reader)
+				reader.Close()
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		obj.Delete(ctx)
+		client.Close()
+	}
+
+}
+
+func TestRetryAfterDuration(t *testing.T) {
+
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"", 0, false},
+		{"0", 0, true},
+		{"5", 5 * time.Second, true},
+		{"120", 2 * time.Minute, true},
+		{"-1", 0, false},
+		{"1.5", 0, false},
+		{"soon", 0, false},
+		{time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0, true},
+	}
+
+	for _, test := range tests {
+		got, ok := retryAfterDuration(test.value)
+		if ok != test.wantOK || got != test.want {
+			t.Errorf("retryAfterDuration(%q) = %v, %t, want %v, %t", test.value, got, ok, test.want, test.wantOK)
+		}
+	}
+
+	got, ok := retryAfterDuration(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	if !ok || got <= 58*time.Minute || got > time.Hour {
+		t.Errorf("retryAfterDuration(future date) = %v, %t, want about 1h, true", got, ok)
+	}
+
+}
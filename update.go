@@ -0,0 +1,65 @@
+package main
+
+import (
+	"strconv"
+
+	"cloud.google.com/go/storage"
+)
+
+func updateObject(storageUnderlyingDataObject *storageUnderlyingDataStruct, bucketName string, objectPath string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	bkt := client.Bucket(bucketName)
+	obj := bkt.Object(objectPath)
+
+	if appFlag.MakePrivate {
+		err := checkObjectACLAllowed(ctx, bkt)
+		if err != nil {
+			fatal(classifyError(err), err.Error())
+		}
+		_, err = objectAttrs(ctx, obj)
+		if err != nil {
+			if err == storage.ErrObjectNotExist {
+				fatal(classifyError(err), "Object does not exist! ("+errorDetail(err)+")")
+			} else {
+				fatal(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
+			}
+		}
+		err = removePublicAccess(ctx, obj)
+		if err != nil {
+			fatal(classifyError(err), err.Error())
+		}
+		if !appFlag.Hold && !appFlag.ReleaseHold {
+			recordObject(objectPath, RecordUpdated, 0, "", 0, nil)
+			LogInfo.Println("SUCCESS: Object made private on GCP Bucket. (Object: " + objectPath + ")")
+			return
+		}
+	}
+
+	var attrsToUpdate storage.ObjectAttrsToUpdate
+	if appFlag.Hold {
+		attrsToUpdate.TemporaryHold = true
+	} else if appFlag.ReleaseHold {
+		attrsToUpdate.TemporaryHold = false
+	}
+
+	objAttrs, err := obj.Update(ctx, attrsToUpdate)
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			fatal(classifyError(err), "Object does not exist! ("+errorDetail(err)+")")
+		} else {
+			fatal(classifyError(err), "Cannot update object! ("+errorDetail(err)+")")
+		}
+	}
+
+	recordObject(objectPath, RecordUpdated, 0, formatCRC32C(objAttrs.CRC32C), 0, nil)
+
+	LogInfo.Println("SUCCESS: Object updated on GCP Bucket. (Object's TEMPORARY HOLD: " + strconv.FormatBool(objAttrs.TemporaryHold) + ", EVENT-BASED HOLD: " + strconv.FormatBool(objAttrs.EventBasedHold) + ", METAGENERATION: " + strconv.FormatInt(objAttrs.Metageneration, 10) + ")")
+
+}
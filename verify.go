@@ -0,0 +1,156 @@
+package main
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/storage"
+)
+
+const (
+	MD5HashPrefix    = "md5:"
+	CRCHashHexPrefix = "hex:"
+)
+
+func offlineVerify() bool {
+
+	return strings.EqualFold(appFlag.ActionType, Verify) && appFlag.ExpectedHash != ""
+
+}
+
+func parseExpectedCRC32C(value string) (uint32, error) {
+
+	value = strings.TrimPrefix(value, "crc32c:")
+
+	hexValue, isHex := strings.CutPrefix(value, CRCHashHexPrefix)
+	if !isHex {
+		hexValue, isHex = strings.CutPrefix(strings.ToLower(value), "0x")
+	}
+
+	switch {
+	case isHex:
+		crc, err := strconv.ParseUint(hexValue, 16, 32)
+		if err != nil {
+			return 0, errors.New("CRC32C must be up to 8 hex digits after hex prefix")
+		}
+		return uint32(crc), nil
+	case strings.HasSuffix(value, "="):
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil || len(decoded) != 4 {
+			return 0, errors.New("CRC32C must be 4 bytes in base64 form")
+		}
+		return binary.BigEndian.Uint32(decoded), nil
+	case value != "" && strings.Trim(value, "0123456789") == "":
+		crc, err := strconv.ParseUint(value, 10, 32)
+		return uint32(crc), err
+	}
+
+	return 0, errors.New("CRC32C must be in base64 or decimal form, or in hex form prefixed with 'hex:' or '0x'")
+
+}
+
+func parseExpectedMD5(value string) ([]byte, error) {
+
+	if decoded, err := hex.DecodeString(value); err == nil && len(decoded) == md5.Size {
+		return decoded, nil
+	}
+	if decoded, err := base64.StdEncoding.DecodeString(value); err == nil && len(decoded) == md5.Size {
+		return decoded, nil
+	}
+
+	return nil, errors.New("MD5 must be 16 bytes in hex or base64 form")
+
+}
+
+func verifyExpectedHash(filePath string, expectedHash string) {
+
+	defer trackRecordObject(filePath)()
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		fatal(classifyError(err), "Cannot open requested file! ("+errorDetail(err)+")")
+	}
+	defer file.Close()
+
+	localCRC, localMD5, err := fileHashes(file)
+	if err != nil {
+		fatal(classifyError(err), "Cannot compute checksums of requested file! ("+errorDetail(err)+")")
+	}
+
+	if md5Value, ok := strings.CutPrefix(expectedHash, MD5HashPrefix); ok {
+		expectedMD5, err := parseExpectedMD5(md5Value)
+		if err != nil {
+			fatal(classifyError(err), "Wrong expected-hash parameter specified! ("+errorDetail(err)+")")
+		}
+		if !bytes.Equal(localMD5, expectedMD5) {
+			fatal(ErrCategoryUnknown, "Local file does not match expected hash! (Expected MD5: "+hex.EncodeToString(expectedMD5)+", Local File's MD5: "+hex.EncodeToString(localMD5)+")")
+		}
+		recordObject(filePath, RecordVerified, 0, formatCRC32C(localCRC), 0, nil)
+		LogInfo.Println("SUCCESS: Local file matches expected hash. (MD5: " + hex.EncodeToString(localMD5) + ")")
+		return
+	}
+
+	expectedCRC, err := parseExpectedCRC32C(expectedHash)
+	if err != nil {
+		fatal(classifyError(err), "Wrong expected-hash parameter specified! ("+errorDetail(err)+")")
+	}
+	if localCRC != expectedCRC {
+		fatal(ErrCategoryUnknown, "Local file does not match expected hash! (Expected CRC32: "+formatCRC32C(expectedCRC)+", Local File's CRC32: "+formatCRC32C(localCRC)+")")
+	}
+
+	recordObject(filePath, RecordVerified, 0, formatCRC32C(localCRC), 0, nil)
+
+	LogInfo.Println("SUCCESS: Local file matches expected hash. (CRC32: " + formatCRC32C(localCRC) + ")")
+
+}
+
+func verifyFile(storageUnderlyingDataObject *storageUnderlyingDataStruct, filePath string, bucketName string, objectPath string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	if appFlag.ExpectedHash != "" {
+		verifyExpectedHash(filePath, appFlag.ExpectedHash)
+		return
+	}
+
+	objAttrs, err := objectAttrs(ctx, preflightObject(client.Bucket(bucketName).Object(objectPath)))
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			fatal(classifyError(err), "Object does not exist! ("+errorDetail(err)+")")
+		} else {
+			fatal(classifyError(err), "Cannot fetch object info! ("+errorDetail(err)+")")
+		}
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		fatal(classifyError(err), "Cannot open requested file! ("+errorDetail(err)+")")
+	}
+	defer file.Close()
+
+	localCRC, err := indexedFileCRC32C(file)
+	if err != nil {
+		fatal(classifyError(err), "Cannot compute checksum of requested file! ("+errorDetail(err)+")")
+	}
+
+	if localCRC != objAttrs.CRC32C {
+		fatal(ErrCategoryUnknown, "Local file does not match object! (Object's CRC32: "+formatCRC32C(objAttrs.CRC32C)+", Local File's CRC32: "+formatCRC32C(localCRC)+")")
+	}
+
+	recordObject(objectPath, RecordVerified, objAttrs.Size, formatCRC32C(localCRC), 0, nil)
+
+	LogInfo.Println("SUCCESS: Local file matches object on GCP Bucket. (CRC32: " + formatCRC32C(localCRC) + ", GENERATION: " + strconv.FormatInt(objAttrs.Generation, 10) + ")")
+
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestParseExpectedCRC32C(t *testing.T) {
+
+	accepted := []struct {
+		value string
+		want  uint32
+	}{
+		{"2591144780", 2591144780},
+		{"crc32c:2591144780", 2591144780},
+		{"mnG7TA==", 2591144780},
+		{"crc32c:mnG7TA==", 2591144780},
+		{"hex:9a71bb4c", 2591144780},
+		{"hex:9A71BB4C", 2591144780},
+		{"0x9a71bb4c", 2591144780},
+		{"0X9A71BB4C", 2591144780},
+		{"crc32c:0x9a71bb4c", 2591144780},
+		{"0x0", 0},
+	}
+	rejected := []string{
+		"",
+		"9a71bb4c",
+		"crc32c:9a71bb4c",
+		"hex:",
+		"hex:9a71bb4c00",
+		"0xzz",
+		"mnG7TA",
+		"AAAAAAA=",
+		"4294967296",
+		"-1",
+	}
+
+	for _, crcFormat := range []string{CRCFormatDecimal, CRCFormatHex, CRCFormatBase64} {
+		appFlag = &AppFlagStruct{CRCFormat: crcFormat}
+
+		for _, test := range accepted {
+			got, err := parseExpectedCRC32C(test.value)
+			if err != nil || got != test.want {
+				t.Errorf("%s: parseExpectedCRC32C(%q) = %d, %v, want %d", crcFormat, test.value, got, err, test.want)
+			}
+		}
+		for _, value := range rejected {
+			if got, err := parseExpectedCRC32C(value); err == nil {
+				t.Errorf("%s: parseExpectedCRC32C(%q) = %d, want error", crcFormat, value, got)
+			}
+		}
+	}
+
+}
+
+func TestParseExpectedMD5(t *testing.T) {
+
+	const want = "5d41402abc4b2a76b9719d911017c592"
+
+	accepted := []string{
+		"5d41402abc4b2a76b9719d911017c592",
+		"5D41402ABC4B2A76B9719D911017C592",
+		"XUFAKrxLKna5cZ2REBfFkg==",
+	}
+	rejected := []string{
+		"",
+		"5d41402abc4b2a76",
+		"5d41402abc4b2a76b9719d911017c59",
+		"5d41402abc4b2a76b9719d911017c59200",
+		"zz41402abc4b2a76b9719d911017c592",
+		"XUFAKrxLKna5cZ2REBfF",
+		"mnG7TA==",
+	}
+
+	for _, value := range accepted {
+		got, err := parseExpectedMD5(value)
+		if err != nil || hex.EncodeToString(got) != want {
+			t.Errorf("parseExpectedMD5(%q) = %x, %v, want %s", value, got, err, want)
+		}
+	}
+	for _, value := range rejected {
+		if got, err := parseExpectedMD5(value); err == nil {
+			t.Errorf("parseExpectedMD5(%q) = %x, want error", value, got)
+		}
+	}
+
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"hash/crc32"
+	"io"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+func downloadVersions(storageUnderlyingDataObject *storageUnderlyingDataStruct, filePath string, bucketName string, objectPath string) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	bkt := client.Bucket(bucketName)
+
+	var generations []int64
+	it := bkt.Objects(ctx, &storage.Query{Prefix: objectPath, Versions: true})
+	for {
+		objAttrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			fatal(classifyError(err), "Cannot list object versions! ("+errorDetail(err)+")")
+		}
+		if objAttrs.Name == objectPath {
+			generations = append(generations, objAttrs.Generation)
+		}
+	}
+
+	if len(generations) == 0 {
+		fatal(ErrCategoryNotFound, "Object does not exist!")
+	}
+	LogInfo.Println("INFO: Object versions found on GCP Bucket. (Versions: " + strconv.Itoa(len(generations)) + ")")
+
+	var downloadedCount, downloadedBytes, failedCount atomic.Int64
+
+	var table summaryTable
+
+	runWorkerPool(int(appFlag.Concurrency), generations, func(gen int64) {
+		objCtx, objCancel := objectContext(ctx)
+		defer objCancel()
+
+		generation := strconv.FormatInt(gen, 10)
+		versionPath := filePath + "." + generation
+
+		objStart := time.Now()
+		bytes, crc, err := downloadVersion(objCtx, bkt.Object(objectPath).Generation(gen).ReadCompressed(appFlag.RawDownload), versionPath)
+		if err != nil {
+			LogErr.Println("ERROR: Cannot download object version! (Generation: " + generation + ", " + errorDetail(err) + ")")
+			failedCount.Add(1)
+			countError(classifyError(err))
+			table.add(objectPath+"#"+generation, "FAILED", bytes, time.Since(objStart))
+			recordObject(objectPath+"#"+generation, RecordFailed, bytes, "", time.Since(objStart), err)
+			return
+		}
+		table.add(objectPath+"#"+generation, "DOWNLOADED", bytes, time.Since(objStart))
+		recordObject(objectPath+"#"+generation, RecordDownloaded, bytes, formatCRC32C(crc), time.Since(objStart), nil)
+
+		LogInfo.Println("INFO: Object version downloaded. (File: " + versionPath + ", SIZE: " + strconv.FormatInt(bytes, 10) + ")")
+		downloadedCount.Add(1)
+		downloadedBytes.Add(bytes)
+		runMetrics.objectsDownloaded.Add(1)
+		runMetrics.bytesTransferred.Add(bytes)
+	})
+
+	table.print()
+
+	summary := "Downloaded Versions: " + strconv.FormatInt(downloadedCount.Load(), 10) + ", Written Bytes: " + strconv.FormatInt(downloadedBytes.Load(), 10)
+
+	if failedCount.Load() > 0 {
+		fatal(ErrCategoryUnknown, "Cannot download some object versions from GCP Bucket! ("+summary+", Failed Versions: "+strconv.FormatInt(failedCount.Load(), 10)+")")
+	}
+
+	LogInfo.Println("SUCCESS: Object versions downloaded from GCP Bucket. (" + summary + ")")
+
+}
+
+func downloadVersion(ctx context.Context, obj *storage.ObjectHandle, versionPath string) (int64, uint32, error) {
+
+	reader, err := openObjectReader(ctx, obj, 0, -1)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer reader.Close()
+
+	partPath := versionPath + PartSuffix
+	file, err := os.Create(partPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer os.Remove(partPath)
+	defer file.Close()
+
+	crcHash := crc32.New(crc32cTable)
+	bytes, err := copyDownload(io.MultiWriter(file, crcHash), transferReader(ctx, reader))
+	if err != nil {
+		return bytes, 0, err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return bytes, 0, err
+	}
+
+	return bytes, crcHash.Sum32(), moveFile(partPath, versionPath)
+
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+const webhookTimeout = 10 * time.Second
+
+type webhookPayloadStruct struct {
+	Bucket     string  `json:"bucket"`
+	Object     string  `json:"object"`
+	Size       int64   `json:"size"`
+	CRC32C     uint32  `json:"crc32c"`
+	Generation int64   `json:"generation"`
+	Duration   float64 `json:"duration_seconds"`
+}
+
+func notifyWebhook(webhookURL string, objAttrs *storage.ObjectAttrs, duration time.Duration) {
+
+	content, err := json.Marshal(webhookPayloadStruct{
+		Bucket:     objAttrs.Bucket,
+		Object:     objAttrs.Name,
+		Size:       objAttrs.Size,
+		CRC32C:     objAttrs.CRC32C,
+		Generation: objAttrs.Generation,
+		Duration:   duration.Seconds(),
+	})
+	if err != nil {
+		LogWarn.Println("WARNING: Cannot encode webhook payload! (" + errorDetail(err) + ")")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(content))
+	if err != nil {
+		LogWarn.Println("WARNING: Cannot create webhook request! (" + errorDetail(err) + ")")
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	transport, err := baseTransport()
+	if err != nil {
+		LogWarn.Println("WARNING: Cannot create TLS config for webhook! (" + errorDetail(err) + ")")
+		return
+	}
+
+	httpClient := &http.Client{Transport: transport}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		LogWarn.Println("WARNING: Cannot deliver webhook! (" + errorDetail(err) + ")")
+		return
+	}
+	res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		LogWarn.Println("WARNING: Webhook rejected by receiver! (Status: " + strconv.Itoa(res.StatusCode) + ")")
+		return
+	}
+
+	LogDebug.Println("DEBUG: Webhook delivered. (Status: " + strconv.Itoa(res.StatusCode) + ")")
+
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+)
+
+func printIdentity(storageUnderlyingDataObject *storageUnderlyingDataStruct) {
+
+	ctx := storageUnderlyingDataObject.ctx
+	cancel := storageUnderlyingDataObject.cancel
+	client := storageUnderlyingDataObject.client
+
+	defer cancel()
+	defer client.Close()
+
+	identity, credentialsType, err := credentialsIdentity(ctx)
+	if err != nil {
+		fatal(classifyError(err), "Cannot resolve identity from credentials! ("+errorDetail(err)+")")
+	}
+
+	fmt.Println(identity)
+
+	LogInfo.Println("SUCCESS: Identity resolved from credentials. (Identity: " + identity + ", Credentials Type: " + credentialsType + ")")
+
+}